@@ -0,0 +1,837 @@
+// Code generated by github.com/zeiss/fiber-htmx/cmd/html. DO NOT EDIT.
+
+package htmx
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/zeiss/pkg/conv"
+)
+
+// RoleAlert sets the role attribute to alert.
+func RoleAlert() Node {
+	return Role("alert")
+}
+
+// RoleAlertDialog sets the role attribute to alertdialog.
+func RoleAlertDialog() Node {
+	return Role("alertdialog")
+}
+
+// RoleApplication sets the role attribute to application.
+func RoleApplication() Node {
+	return Role("application")
+}
+
+// RoleArticle sets the role attribute to article.
+func RoleArticle() Node {
+	return Role("article")
+}
+
+// RoleBanner sets the role attribute to banner.
+func RoleBanner() Node {
+	return Role("banner")
+}
+
+// RoleBlockQuote sets the role attribute to blockquote.
+func RoleBlockQuote() Node {
+	return Role("blockquote")
+}
+
+// RoleButton sets the role attribute to button.
+func RoleButton() Node {
+	return Role("button")
+}
+
+// RoleCaption sets the role attribute to caption.
+func RoleCaption() Node {
+	return Role("caption")
+}
+
+// RoleCell sets the role attribute to cell.
+func RoleCell() Node {
+	return Role("cell")
+}
+
+// RoleCheckBox sets the role attribute to checkbox.
+func RoleCheckBox() Node {
+	return Role("checkbox")
+}
+
+// RoleCode sets the role attribute to code.
+func RoleCode() Node {
+	return Role("code")
+}
+
+// RoleColumnHeader sets the role attribute to columnheader.
+func RoleColumnHeader() Node {
+	return Role("columnheader")
+}
+
+// RoleComboBox sets the role attribute to combobox.
+func RoleComboBox() Node {
+	return Role("combobox")
+}
+
+// RoleComplementary sets the role attribute to complementary.
+func RoleComplementary() Node {
+	return Role("complementary")
+}
+
+// RoleContentInfo sets the role attribute to contentinfo.
+func RoleContentInfo() Node {
+	return Role("contentinfo")
+}
+
+// RoleDefinition sets the role attribute to definition.
+func RoleDefinition() Node {
+	return Role("definition")
+}
+
+// RoleDeletion sets the role attribute to deletion.
+func RoleDeletion() Node {
+	return Role("deletion")
+}
+
+// RoleDialog sets the role attribute to dialog.
+func RoleDialog() Node {
+	return Role("dialog")
+}
+
+// RoleDocument sets the role attribute to document.
+func RoleDocument() Node {
+	return Role("document")
+}
+
+// RoleEmphasis sets the role attribute to emphasis.
+func RoleEmphasis() Node {
+	return Role("emphasis")
+}
+
+// RoleFeed sets the role attribute to feed.
+func RoleFeed() Node {
+	return Role("feed")
+}
+
+// RoleFigure sets the role attribute to figure.
+func RoleFigure() Node {
+	return Role("figure")
+}
+
+// RoleForm sets the role attribute to form.
+func RoleForm() Node {
+	return Role("form")
+}
+
+// RoleGeneric sets the role attribute to generic.
+func RoleGeneric() Node {
+	return Role("generic")
+}
+
+// RoleGrid sets the role attribute to grid.
+func RoleGrid() Node {
+	return Role("grid")
+}
+
+// RoleGridCell sets the role attribute to gridcell.
+func RoleGridCell() Node {
+	return Role("gridcell")
+}
+
+// RoleGroup sets the role attribute to group.
+func RoleGroup() Node {
+	return Role("group")
+}
+
+// RoleHeading sets the role attribute to heading.
+func RoleHeading() Node {
+	return Role("heading")
+}
+
+// RoleImg sets the role attribute to img.
+func RoleImg() Node {
+	return Role("img")
+}
+
+// RoleInsertion sets the role attribute to insertion.
+func RoleInsertion() Node {
+	return Role("insertion")
+}
+
+// RoleLink sets the role attribute to link.
+func RoleLink() Node {
+	return Role("link")
+}
+
+// RoleList sets the role attribute to list.
+func RoleList() Node {
+	return Role("list")
+}
+
+// RoleListBox sets the role attribute to listbox.
+func RoleListBox() Node {
+	return Role("listbox")
+}
+
+// RoleListItem sets the role attribute to listitem.
+func RoleListItem() Node {
+	return Role("listitem")
+}
+
+// RoleLog sets the role attribute to log.
+func RoleLog() Node {
+	return Role("log")
+}
+
+// RoleMain sets the role attribute to main.
+func RoleMain() Node {
+	return Role("main")
+}
+
+// RoleMarquee sets the role attribute to marquee.
+func RoleMarquee() Node {
+	return Role("marquee")
+}
+
+// RoleMath sets the role attribute to math.
+func RoleMath() Node {
+	return Role("math")
+}
+
+// RoleMenu sets the role attribute to menu.
+func RoleMenu() Node {
+	return Role("menu")
+}
+
+// RoleMenuBar sets the role attribute to menubar.
+func RoleMenuBar() Node {
+	return Role("menubar")
+}
+
+// RoleMenuItem sets the role attribute to menuitem.
+func RoleMenuItem() Node {
+	return Role("menuitem")
+}
+
+// RoleMenuItemCheckBox sets the role attribute to menuitemcheckbox.
+func RoleMenuItemCheckBox() Node {
+	return Role("menuitemcheckbox")
+}
+
+// RoleMenuItemRadio sets the role attribute to menuitemradio.
+func RoleMenuItemRadio() Node {
+	return Role("menuitemradio")
+}
+
+// RoleMeter sets the role attribute to meter.
+func RoleMeter() Node {
+	return Role("meter")
+}
+
+// RoleNavigation sets the role attribute to navigation.
+func RoleNavigation() Node {
+	return Role("navigation")
+}
+
+// RoleNone sets the role attribute to none.
+func RoleNone() Node {
+	return Role("none")
+}
+
+// RoleNote sets the role attribute to note.
+func RoleNote() Node {
+	return Role("note")
+}
+
+// RoleOption sets the role attribute to option.
+func RoleOption() Node {
+	return Role("option")
+}
+
+// RoleParagraph sets the role attribute to paragraph.
+func RoleParagraph() Node {
+	return Role("paragraph")
+}
+
+// RolePresentation sets the role attribute to presentation.
+func RolePresentation() Node {
+	return Role("presentation")
+}
+
+// RoleProgressBar sets the role attribute to progressbar.
+func RoleProgressBar() Node {
+	return Role("progressbar")
+}
+
+// RoleRadio sets the role attribute to radio.
+func RoleRadio() Node {
+	return Role("radio")
+}
+
+// RoleRadioGroup sets the role attribute to radiogroup.
+func RoleRadioGroup() Node {
+	return Role("radiogroup")
+}
+
+// RoleRegion sets the role attribute to region.
+func RoleRegion() Node {
+	return Role("region")
+}
+
+// RoleRow sets the role attribute to row.
+func RoleRow() Node {
+	return Role("row")
+}
+
+// RoleRowGroup sets the role attribute to rowgroup.
+func RoleRowGroup() Node {
+	return Role("rowgroup")
+}
+
+// RoleRowHeader sets the role attribute to rowheader.
+func RoleRowHeader() Node {
+	return Role("rowheader")
+}
+
+// RoleScrollBar sets the role attribute to scrollbar.
+func RoleScrollBar() Node {
+	return Role("scrollbar")
+}
+
+// RoleSearch sets the role attribute to search.
+func RoleSearch() Node {
+	return Role("search")
+}
+
+// RoleSearchBox sets the role attribute to searchbox.
+func RoleSearchBox() Node {
+	return Role("searchbox")
+}
+
+// RoleSeparator sets the role attribute to separator.
+func RoleSeparator() Node {
+	return Role("separator")
+}
+
+// RoleSlider sets the role attribute to slider.
+func RoleSlider() Node {
+	return Role("slider")
+}
+
+// RoleSpinButton sets the role attribute to spinbutton.
+func RoleSpinButton() Node {
+	return Role("spinbutton")
+}
+
+// RoleStatus sets the role attribute to status.
+func RoleStatus() Node {
+	return Role("status")
+}
+
+// RoleStrong sets the role attribute to strong.
+func RoleStrong() Node {
+	return Role("strong")
+}
+
+// RoleSubscript sets the role attribute to subscript.
+func RoleSubscript() Node {
+	return Role("subscript")
+}
+
+// RoleSuperscript sets the role attribute to superscript.
+func RoleSuperscript() Node {
+	return Role("superscript")
+}
+
+// RoleSwitch sets the role attribute to switch.
+func RoleSwitch() Node {
+	return Role("switch")
+}
+
+// RoleTab sets the role attribute to tab.
+func RoleTab() Node {
+	return Role("tab")
+}
+
+// RoleTable sets the role attribute to table.
+func RoleTable() Node {
+	return Role("table")
+}
+
+// RoleTabList sets the role attribute to tablist.
+func RoleTabList() Node {
+	return Role("tablist")
+}
+
+// RoleTabPanel sets the role attribute to tabpanel.
+func RoleTabPanel() Node {
+	return Role("tabpanel")
+}
+
+// RoleTerm sets the role attribute to term.
+func RoleTerm() Node {
+	return Role("term")
+}
+
+// RoleTextBox sets the role attribute to textbox.
+func RoleTextBox() Node {
+	return Role("textbox")
+}
+
+// RoleTime sets the role attribute to time.
+func RoleTime() Node {
+	return Role("time")
+}
+
+// RoleTimer sets the role attribute to timer.
+func RoleTimer() Node {
+	return Role("timer")
+}
+
+// RoleToolBar sets the role attribute to toolbar.
+func RoleToolBar() Node {
+	return Role("toolbar")
+}
+
+// RoleToolTip sets the role attribute to tooltip.
+func RoleToolTip() Node {
+	return Role("tooltip")
+}
+
+// RoleTree sets the role attribute to tree.
+func RoleTree() Node {
+	return Role("tree")
+}
+
+// RoleTreeGrid sets the role attribute to treegrid.
+func RoleTreeGrid() Node {
+	return Role("treegrid")
+}
+
+// RoleTreeItem sets the role attribute to treeitem.
+func RoleTreeItem() Node {
+	return Role("treeitem")
+}
+
+// AriaActiveDescendant sets the aria-activedescendant attribute for elements.
+func AriaActiveDescendant(id string) Node {
+	return Attribute("aria-activedescendant", id)
+}
+
+// AriaAtomic sets the aria-atomic attribute for elements.
+func AriaAtomic(v bool) Node {
+	return Attribute("aria-atomic", conv.String(v))
+}
+
+// AriaAutoCompleteValue is a value of the aria-autocomplete attribute.
+type AriaAutoCompleteValue string
+
+// String returns the string representation of the AriaAutoCompleteValue.
+func (v AriaAutoCompleteValue) String() string {
+	return string(v)
+}
+
+// List of values of the aria-autocomplete attribute.
+const (
+	AriaAutoCompleteInline AriaAutoCompleteValue = "inline"
+	AriaAutoCompleteList   AriaAutoCompleteValue = "list"
+	AriaAutoCompleteBoth   AriaAutoCompleteValue = "both"
+	AriaAutoCompleteNone   AriaAutoCompleteValue = "none"
+)
+
+// AriaAutoComplete sets the aria-autocomplete attribute for elements.
+func AriaAutoComplete(v AriaAutoCompleteValue) Node {
+	return Attribute("aria-autocomplete", v.String())
+}
+
+// AriaBrailleLabel sets the aria-braillelabel attribute for elements.
+func AriaBrailleLabel(v string) Node {
+	return Attribute("aria-braillelabel", v)
+}
+
+// AriaBrailleRoleDescription sets the aria-brailleroledescription attribute for elements.
+func AriaBrailleRoleDescription(v string) Node {
+	return Attribute("aria-brailleroledescription", v)
+}
+
+// AriaBusy sets the aria-busy attribute for elements.
+func AriaBusy(v bool) Node {
+	return Attribute("aria-busy", conv.String(v))
+}
+
+// AriaCheckedValue is a value of the aria-checked attribute.
+type AriaCheckedValue string
+
+// String returns the string representation of the AriaCheckedValue.
+func (v AriaCheckedValue) String() string {
+	return string(v)
+}
+
+// List of values of the aria-checked attribute.
+const (
+	AriaCheckedTrue  AriaCheckedValue = "true"
+	AriaCheckedFalse AriaCheckedValue = "false"
+	AriaCheckedMixed AriaCheckedValue = "mixed"
+)
+
+// AriaChecked sets the aria-checked attribute for elements.
+func AriaChecked(v AriaCheckedValue) Node {
+	return Attribute("aria-checked", v.String())
+}
+
+// AriaColCount sets the aria-colcount attribute for elements.
+func AriaColCount(v int) Node {
+	return Attribute("aria-colcount", strconv.Itoa(v))
+}
+
+// AriaColIndex sets the aria-colindex attribute for elements.
+func AriaColIndex(v int) Node {
+	return Attribute("aria-colindex", strconv.Itoa(v))
+}
+
+// AriaColIndexText sets the aria-colindextext attribute for elements.
+func AriaColIndexText(v string) Node {
+	return Attribute("aria-colindextext", v)
+}
+
+// AriaColSpan sets the aria-colspan attribute for elements.
+func AriaColSpan(v int) Node {
+	return Attribute("aria-colspan", strconv.Itoa(v))
+}
+
+// AriaControls sets the aria-controls attribute for elements.
+func AriaControls(ids ...string) Node {
+	return Attribute("aria-controls", strings.Join(ids, " "))
+}
+
+// AriaCurrentValue is a value of the aria-current attribute.
+type AriaCurrentValue string
+
+// String returns the string representation of the AriaCurrentValue.
+func (v AriaCurrentValue) String() string {
+	return string(v)
+}
+
+// List of values of the aria-current attribute.
+const (
+	AriaCurrentPage     AriaCurrentValue = "page"
+	AriaCurrentStep     AriaCurrentValue = "step"
+	AriaCurrentLocation AriaCurrentValue = "location"
+	AriaCurrentDate     AriaCurrentValue = "date"
+	AriaCurrentTime     AriaCurrentValue = "time"
+	AriaCurrentTrue     AriaCurrentValue = "true"
+	AriaCurrentFalse    AriaCurrentValue = "false"
+)
+
+// AriaCurrent sets the aria-current attribute for elements.
+func AriaCurrent(v AriaCurrentValue) Node {
+	return Attribute("aria-current", v.String())
+}
+
+// AriaDescribedBy sets the aria-describedby attribute for elements.
+func AriaDescribedBy(ids ...string) Node {
+	return Attribute("aria-describedby", strings.Join(ids, " "))
+}
+
+// AriaDescription sets the aria-description attribute for elements.
+func AriaDescription(v string) Node {
+	return Attribute("aria-description", v)
+}
+
+// AriaDetails sets the aria-details attribute for elements.
+func AriaDetails(ids ...string) Node {
+	return Attribute("aria-details", strings.Join(ids, " "))
+}
+
+// AriaDisabled sets the aria-disabled attribute for elements.
+func AriaDisabled(v bool) Node {
+	return Attribute("aria-disabled", conv.String(v))
+}
+
+// AriaErrorMessage sets the aria-errormessage attribute for elements.
+func AriaErrorMessage(ids ...string) Node {
+	return Attribute("aria-errormessage", strings.Join(ids, " "))
+}
+
+// AriaExpanded sets the aria-expanded attribute for elements.
+func AriaExpanded(v bool) Node {
+	return Attribute("aria-expanded", conv.String(v))
+}
+
+// AriaFlowTo sets the aria-flowto attribute for elements.
+func AriaFlowTo(ids ...string) Node {
+	return Attribute("aria-flowto", strings.Join(ids, " "))
+}
+
+// AriaHasPopupValue is a value of the aria-haspopup attribute.
+type AriaHasPopupValue string
+
+// String returns the string representation of the AriaHasPopupValue.
+func (v AriaHasPopupValue) String() string {
+	return string(v)
+}
+
+// List of values of the aria-haspopup attribute.
+const (
+	AriaHasPopupFalse   AriaHasPopupValue = "false"
+	AriaHasPopupTrue    AriaHasPopupValue = "true"
+	AriaHasPopupMenu    AriaHasPopupValue = "menu"
+	AriaHasPopupListbox AriaHasPopupValue = "listbox"
+	AriaHasPopupTree    AriaHasPopupValue = "tree"
+	AriaHasPopupGrid    AriaHasPopupValue = "grid"
+	AriaHasPopupDialog  AriaHasPopupValue = "dialog"
+)
+
+// AriaHasPopup sets the aria-haspopup attribute for elements.
+func AriaHasPopup(v AriaHasPopupValue) Node {
+	return Attribute("aria-haspopup", v.String())
+}
+
+// AriaHidden sets the aria-hidden attribute for elements.
+func AriaHidden(v bool) Node {
+	return Attribute("aria-hidden", conv.String(v))
+}
+
+// AriaInvalidValue is a value of the aria-invalid attribute.
+type AriaInvalidValue string
+
+// String returns the string representation of the AriaInvalidValue.
+func (v AriaInvalidValue) String() string {
+	return string(v)
+}
+
+// List of values of the aria-invalid attribute.
+const (
+	AriaInvalidFalse    AriaInvalidValue = "false"
+	AriaInvalidTrue     AriaInvalidValue = "true"
+	AriaInvalidGrammar  AriaInvalidValue = "grammar"
+	AriaInvalidSpelling AriaInvalidValue = "spelling"
+)
+
+// AriaInvalid sets the aria-invalid attribute for elements.
+func AriaInvalid(v AriaInvalidValue) Node {
+	return Attribute("aria-invalid", v.String())
+}
+
+// AriaKeyShortcuts sets the aria-keyshortcuts attribute for elements.
+func AriaKeyShortcuts(v string) Node {
+	return Attribute("aria-keyshortcuts", v)
+}
+
+// AriaLabel sets the aria-label attribute for elements.
+func AriaLabel(v string) Node {
+	return Attribute("aria-label", v)
+}
+
+// AriaLabelledBy sets the aria-labelledby attribute for elements.
+func AriaLabelledBy(ids ...string) Node {
+	return Attribute("aria-labelledby", strings.Join(ids, " "))
+}
+
+// AriaLevel sets the aria-level attribute for elements.
+func AriaLevel(v int) Node {
+	return Attribute("aria-level", strconv.Itoa(v))
+}
+
+// AriaLiveValue is a value of the aria-live attribute.
+type AriaLiveValue string
+
+// String returns the string representation of the AriaLiveValue.
+func (v AriaLiveValue) String() string {
+	return string(v)
+}
+
+// List of values of the aria-live attribute.
+const (
+	AriaLiveOff       AriaLiveValue = "off"
+	AriaLivePolite    AriaLiveValue = "polite"
+	AriaLiveAssertive AriaLiveValue = "assertive"
+)
+
+// AriaLive sets the aria-live attribute for elements.
+func AriaLive(v AriaLiveValue) Node {
+	return Attribute("aria-live", v.String())
+}
+
+// AriaModal sets the aria-modal attribute for elements.
+func AriaModal(v bool) Node {
+	return Attribute("aria-modal", conv.String(v))
+}
+
+// AriaMultiLine sets the aria-multiline attribute for elements.
+func AriaMultiLine(v bool) Node {
+	return Attribute("aria-multiline", conv.String(v))
+}
+
+// AriaMultiSelectable sets the aria-multiselectable attribute for elements.
+func AriaMultiSelectable(v bool) Node {
+	return Attribute("aria-multiselectable", conv.String(v))
+}
+
+// AriaOrientationValue is a value of the aria-orientation attribute.
+type AriaOrientationValue string
+
+// String returns the string representation of the AriaOrientationValue.
+func (v AriaOrientationValue) String() string {
+	return string(v)
+}
+
+// List of values of the aria-orientation attribute.
+const (
+	AriaOrientationHorizontal AriaOrientationValue = "horizontal"
+	AriaOrientationVertical   AriaOrientationValue = "vertical"
+	AriaOrientationUndefined  AriaOrientationValue = "undefined"
+)
+
+// AriaOrientation sets the aria-orientation attribute for elements.
+func AriaOrientation(v AriaOrientationValue) Node {
+	return Attribute("aria-orientation", v.String())
+}
+
+// AriaOwns sets the aria-owns attribute for elements.
+func AriaOwns(ids ...string) Node {
+	return Attribute("aria-owns", strings.Join(ids, " "))
+}
+
+// AriaPlaceholder sets the aria-placeholder attribute for elements.
+func AriaPlaceholder(v string) Node {
+	return Attribute("aria-placeholder", v)
+}
+
+// AriaPosInSet sets the aria-posinset attribute for elements.
+func AriaPosInSet(v int) Node {
+	return Attribute("aria-posinset", strconv.Itoa(v))
+}
+
+// AriaPressedValue is a value of the aria-pressed attribute.
+type AriaPressedValue string
+
+// String returns the string representation of the AriaPressedValue.
+func (v AriaPressedValue) String() string {
+	return string(v)
+}
+
+// List of values of the aria-pressed attribute.
+const (
+	AriaPressedTrue  AriaPressedValue = "true"
+	AriaPressedFalse AriaPressedValue = "false"
+	AriaPressedMixed AriaPressedValue = "mixed"
+)
+
+// AriaPressed sets the aria-pressed attribute for elements.
+func AriaPressed(v AriaPressedValue) Node {
+	return Attribute("aria-pressed", v.String())
+}
+
+// AriaReadOnly sets the aria-readonly attribute for elements.
+func AriaReadOnly(v bool) Node {
+	return Attribute("aria-readonly", conv.String(v))
+}
+
+// AriaRelevantValue is a value of the aria-relevant attribute.
+type AriaRelevantValue string
+
+// String returns the string representation of the AriaRelevantValue.
+func (v AriaRelevantValue) String() string {
+	return string(v)
+}
+
+// List of values of the aria-relevant attribute.
+const (
+	AriaRelevantAdditions AriaRelevantValue = "additions"
+	AriaRelevantRemovals  AriaRelevantValue = "removals"
+	AriaRelevantText      AriaRelevantValue = "text"
+	AriaRelevantAll       AriaRelevantValue = "all"
+)
+
+// AriaRelevant sets the aria-relevant attribute for elements.
+func AriaRelevant(v ...AriaRelevantValue) Node {
+	tokens := make([]string, 0, len(v))
+	for _, t := range v {
+		tokens = append(tokens, t.String())
+	}
+
+	return Attribute("aria-relevant", strings.Join(tokens, " "))
+}
+
+// AriaRequired sets the aria-required attribute for elements.
+func AriaRequired(v bool) Node {
+	return Attribute("aria-required", conv.String(v))
+}
+
+// AriaRoleDescription sets the aria-roledescription attribute for elements.
+func AriaRoleDescription(v string) Node {
+	return Attribute("aria-roledescription", v)
+}
+
+// AriaRowCount sets the aria-rowcount attribute for elements.
+func AriaRowCount(v int) Node {
+	return Attribute("aria-rowcount", strconv.Itoa(v))
+}
+
+// AriaRowIndex sets the aria-rowindex attribute for elements.
+func AriaRowIndex(v int) Node {
+	return Attribute("aria-rowindex", strconv.Itoa(v))
+}
+
+// AriaRowIndexText sets the aria-rowindextext attribute for elements.
+func AriaRowIndexText(v string) Node {
+	return Attribute("aria-rowindextext", v)
+}
+
+// AriaRowSpan sets the aria-rowspan attribute for elements.
+func AriaRowSpan(v int) Node {
+	return Attribute("aria-rowspan", strconv.Itoa(v))
+}
+
+// AriaSelected sets the aria-selected attribute for elements.
+func AriaSelected(v bool) Node {
+	return Attribute("aria-selected", conv.String(v))
+}
+
+// AriaSetSize sets the aria-setsize attribute for elements.
+func AriaSetSize(v int) Node {
+	return Attribute("aria-setsize", strconv.Itoa(v))
+}
+
+// AriaSortValue is a value of the aria-sort attribute.
+type AriaSortValue string
+
+// String returns the string representation of the AriaSortValue.
+func (v AriaSortValue) String() string {
+	return string(v)
+}
+
+// List of values of the aria-sort attribute.
+const (
+	AriaSortAscending  AriaSortValue = "ascending"
+	AriaSortDescending AriaSortValue = "descending"
+	AriaSortNone       AriaSortValue = "none"
+	AriaSortOther      AriaSortValue = "other"
+)
+
+// AriaSort sets the aria-sort attribute for elements.
+func AriaSort(v AriaSortValue) Node {
+	return Attribute("aria-sort", v.String())
+}
+
+// AriaValueMax sets the aria-valuemax attribute for elements.
+func AriaValueMax(v float64) Node {
+	return Attribute("aria-valuemax", strconv.FormatFloat(v, 'f', -1, 64))
+}
+
+// AriaValueMin sets the aria-valuemin attribute for elements.
+func AriaValueMin(v float64) Node {
+	return Attribute("aria-valuemin", strconv.FormatFloat(v, 'f', -1, 64))
+}
+
+// AriaValueNow sets the aria-valuenow attribute for elements.
+func AriaValueNow(v float64) Node {
+	return Attribute("aria-valuenow", strconv.FormatFloat(v, 'f', -1, 64))
+}
+
+// AriaValueText sets the aria-valuetext attribute for elements.
+func AriaValueText(v string) Node {
+	return Attribute("aria-valuetext", v)
+}
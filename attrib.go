@@ -6,7 +6,10 @@ package htmx
 import (
 	"encoding/json"
 	"fmt"
+	"io"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/zeiss/pkg/conv"
 	"github.com/zeiss/pkg/errorx"
@@ -177,6 +180,13 @@ const (
 	HxAttributeSync        HxAttribute = "hx-sync"
 	HxAttributeParams      HxAttribute = "hx-params"
 	HxAttributeVals        HxAttribute = "hx-vals"
+	HxAttributeReplaceUrl  HxAttribute = "hx-replace-url"
+	HxAttributeHistory     HxAttribute = "hx-history"
+	HxAttributeHistoryElt  HxAttribute = "hx-history-elt"
+	HxAttributePreserve    HxAttribute = "hx-preserve"
+	HxAttributeDisinherit  HxAttribute = "hx-disinherit"
+	HxAttributeInherit     HxAttribute = "hx-inherit"
+	HxAttributeRequest     HxAttribute = "hx-request"
 )
 
 // HxBoost sets the hx-boost attribute to enable or disable boosting.
@@ -196,7 +206,22 @@ func HxPost(url string) Node {
 
 // HxPushUrl sets the hx-push-url attribute to enable or disable URL pushing.
 func HxPushUrl(v bool) Node {
-	return Attribute(HxAttributeBoost.String(), conv.String(v))
+	return Attribute(HxAttributePushUrl.String(), conv.String(v))
+}
+
+// HxPushUrlTo sets the hx-push-url attribute to push the given URL into the browser location history.
+func HxPushUrlTo(url string) Node {
+	return Attribute(HxAttributePushUrl.String(), url)
+}
+
+// HxReplaceUrl sets the hx-replace-url attribute to enable or disable replacing the URL.
+func HxReplaceUrl(v bool) Node {
+	return Attribute(HxAttributeReplaceUrl.String(), conv.String(v))
+}
+
+// HxReplaceUrlTo sets the hx-replace-url attribute to replace the current URL with the given URL.
+func HxReplaceUrlTo(url string) Node {
+	return Attribute(HxAttributeReplaceUrl.String(), url)
 }
 
 // HxTarget sets the hx-target attribute to specify the target element for the response.
@@ -227,20 +252,21 @@ func HxSwapOob(target string) Node {
 const (
 	TriggerClick        = "click"
 	TriggerClickOnce    = TriggerClick + " once"
-	TriggerDblClick     = JSEventTypeDblClickEvent
+	TriggerDblClick     = "dblclick"
 	TriggerKeyUpEnter   = "keyup[keyCode==13]"
 	TriggerEnterPressed = TriggerKeyUpEnter
 	TriggerBlur         = "blur"
-	TriggerEvery1s      = "every:1s"
-	TriggerEvery2s      = "every:2s"
-	TriggerEvery5s      = "every:5s"
-	TriggerEvery10s     = "every:10s"
-	TriggerEvery30s     = "every:30s"
-	TriggerEvery1m      = "every:1m"
-	TriggerLoad         = "onload"
+	TriggerEvery1s      = "every 1s"
+	TriggerEvery2s      = "every 2s"
+	TriggerEvery5s      = "every 5s"
+	TriggerEvery10s     = "every 10s"
+	TriggerEvery30s     = "every 30s"
+	TriggerEvery1m      = "every 1m"
+	TriggerLoad         = "load"
 )
 
 // HxTrigger sets the hx-trigger attribute to specify the target element for triggering an event.
+// Use HxTriggerSpecs for validated trigger specifications.
 func HxTrigger(targets ...string) Node {
 	var b strings.Builder
 	for i, t := range targets {
@@ -268,11 +294,44 @@ func HxDelete(url string) Node {
 	return Attribute(HxAttributeDelete.String(), url)
 }
 
-// HxOn sets the hx-put-{target} attribute to specify the JavaScript code to execute on a PUT request.
+// HxOn sets the hx-on:{target} attribute to specify the JavaScript code to execute on an event.
 func HxOn(target string, js string) Node {
 	return Attribute(fmt.Sprintf("hx-on:%s", target), js)
 }
 
+// HxOnEvent sets the hx-on::{event} attribute to specify the JavaScript code to execute on an htmx event.
+// The event name is converted to kebab-case, multiple statements are joined into one handler.
+func HxOnEvent(event HxEventType, js ...string) Node {
+	name := strings.TrimPrefix(event.String(), "htmx:")
+
+	return Attribute("hx-on::"+kebabEvent(name), strings.Join(js, "; "))
+}
+
+// OnDOM sets the hx-on:{event} attribute to specify the JavaScript code to execute on a DOM event.
+// Multiple statements are joined into one handler.
+func OnDOM(event JSEventType, js ...string) Node {
+	name := strings.TrimPrefix(event.String(), "on")
+
+	return Attribute("hx-on:"+kebabEvent(name), strings.Join(js, "; "))
+}
+
+// kebabEvent converts the camelCase segments of an event name to kebab-case e.g. afterRequest to after-request.
+func kebabEvent(name string) string {
+	var b strings.Builder
+
+	for i, r := range name {
+		if r >= 'A' && r <= 'Z' {
+			if i > 0 && name[i-1] != ':' {
+				b.WriteByte('-')
+			}
+			r += 'a' - 'A'
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
 // HxPut sets the hx-put attribute to specify the URL for PUT requests.
 func HxPut(url string) Node {
 	return Attribute(HxAttributePut.String(), url)
@@ -335,7 +394,7 @@ func HxTargetError(target string) Node {
 
 // HxTarget50x sets the hx-target-50x attribute to specify the target element for 50x responses.
 func HxTarget50x(target string) Node {
-	return Attribute(HxAttributeTarget5xx.String(), target)
+	return Attribute(HxAttributeTarget50x.String(), target)
 }
 
 // HxDisable sets the hx-disable attribute to disable htmx functionality.
@@ -363,294 +422,163 @@ func HxHeaders(headers map[string]string) Node {
 	return Attribute("hx-headers", string(errorx.Ignore(json.Marshal(headers))))
 }
 
-// Async sets the async attribute for script elements.
-func Async() Node {
-	return Attribute("async")
-}
-
-// AutoFocus sets the autofocus attribute for form elements.
-func AutoFocus() Node {
-	return Attribute("autofocus")
+// HxVals sets the hx-vals attribute to add the JSON encoded values to the parameters of the request.
+func HxVals(v any) Node {
+	return Attribute(HxAttributeVals.String(), string(errorx.Ignore(json.Marshal(v))))
 }
 
-// AutoPlay sets the autoplay attribute for media elements.
-func AutoPlay() Node {
-	return Attribute("autoplay")
+// HxValsJS sets the hx-vals attribute to add the values of a JavaScript expression to the parameters of the request.
+func HxValsJS(expr string) Node {
+	return Attribute(HxAttributeVals.String(), "js:"+expr)
 }
 
-// Checked sets the checked attribute for input elements.
-func Checked() Node {
-	return Attribute("checked")
-}
-
-// Controls sets the controls attribute for media elements.
-func Controls() Node {
-	return Attribute("controls")
-}
-
-// Defer sets the defer attribute for script elements.
-func Defer() Node {
-	return Attribute("defer")
-}
-
-// Disabled sets the disabled attribute for form elements.
-func Disabled() Node {
-	return Attribute("disabled")
-}
-
-// Loop sets the loop attribute for media elements.
-func Loop() Node {
-	return Attribute("loop")
-}
-
-// Multiple sets the multiple attribute for input elements.
-func Multiple() Node {
-	return Attribute("multiple")
-}
-
-// Muted sets the muted attribute for media elements.
-func Muted() Node {
-	return Attribute("muted")
-}
-
-// PlaysInline sets the playsinline attribute for media elements.
-func PlaysInline() Node {
-	return Attribute("playsinline")
-}
-
-// ReadOnly sets the readonly attribute for form elements.
-func ReadOnly() Node {
-	return Attribute("readonly")
-}
-
-// Required sets the required attribute for form elements.
-func Required() Node {
-	return Attribute("required")
-}
-
-// Selected sets the selected attribute for option elements.
-func Selected() Node {
-	return Attribute("selected")
-}
-
-// Accept sets the accept attribute for file input elements.
-func Accept(v string) Node {
-	return Attribute("accept", v)
-}
-
-// Action sets the action attribute for form elements.
-func Action(v string) Node {
-	return Attribute("action", v)
-}
-
-// Alt sets the alt attribute for image elements.
-func Alt(v string) Node {
-	return Attribute("alt", v)
-}
-
-// Aria sets the aria-{name} attribute for elements.
-func Aria(name, v string) Node {
-	return Attribute("aria-"+name, v)
-}
-
-// As sets the as attribute for link elements.
-func As(v string) Node {
-	return Attribute("as", v)
-}
-
-// AutoComplete sets the autocomplete attribute for form elements.
-func AutoComplete(v string) Node {
-	return Attribute("autocomplete", v)
-}
-
-// Charset sets the charset attribute for meta elements.
-func Charset(v string) Node {
-	return Attribute("charset", v)
-}
-
-// Class sets the class attribute for elements.
-func Class(v string) Node {
-	return Attribute("class", v)
-}
-
-// Cols sets the cols attribute for textarea elements.
-func Cols(v string) Node {
-	return Attribute("cols", v)
-}
-
-// ColSpan sets the colspan attribute for table cells.
-func ColSpan(v string) Node {
-	return Attribute("colspan", v)
-}
-
-// Content sets the content attribute for meta elements.
-func Content(v string) Node {
-	return Attribute("content", v)
-}
-
-// DataAttribute sets the data-{name} attribute for elements.
-func DataAttribute(name, v string) Node {
-	return Attribute("data-"+name, v)
-}
-
-// For sets the for attribute for label elements.
-func For(v string) Node {
-	return Attribute("for", v)
-}
-
-// FormAttribute sets the form attribute for elements.
-func FormAttribute(v string) Node {
-	return Attribute("form", v)
-}
-
-// Height sets the height attribute for elements.
-func Height(v string) Node {
-	return Attribute("height", v)
-}
-
-// Href sets the href attribute for anchor elements.
-func Href(v string) Node {
-	return Attribute("href", v)
-}
-
-// ID sets the id attribute for elements.
-func ID(v string) Node {
-	return Attribute("id", v)
-}
-
-// Lang sets the lang attribute for elements.
-func Lang(v string) Node {
-	return Attribute("lang", v)
-}
+// HxVars sets the hx-vars attribute to add the values of JavaScript expressions to the parameters of the request.
+// Deprecated: hx-vars is deprecated by htmx, use HxValsJS instead.
+func HxVars(vars map[string]string) Node {
+	keys := make([]string, 0, len(vars))
+	for k := range vars {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
 
-// Loading sets the loading attribute for elements.
-func Loading(v string) Node {
-	return Attribute("loading", v)
-}
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+":"+vars[k])
+	}
 
-// Max sets the max attribute for input elements.
-func Max(v string) Node {
-	return Attribute("max", v)
+	return Attribute(HxAttributeVars.String(), strings.Join(pairs, ", "))
 }
 
-// MaxLength sets the maxlength attribute for input elements.
-func MaxLength(v string) Node {
-	return Attribute("maxlength", v)
+// HxParamsAll sets the hx-params attribute to include all parameters.
+func HxParamsAll() Node {
+	return Attribute(HxAttributeParams.String(), "*")
 }
 
-// Method sets the method attribute for form elements.
-func Method(v string) Node {
-	return Attribute("method", v)
+// HxParamsNone sets the hx-params attribute to include no parameters.
+func HxParamsNone() Node {
+	return Attribute(HxAttributeParams.String(), "none")
 }
 
-// Min sets the min attribute for input elements.
-func Min(v string) Node {
-	return Attribute("min", v)
+// HxParamsInclude sets the hx-params attribute to include only the given parameters.
+func HxParamsInclude(names ...string) Node {
+	return Attribute(HxAttributeParams.String(), strings.Join(names, ","))
 }
 
-// MinLength sets the minlength attribute for input elements.
-func MinLength(v string) Node {
-	return Attribute("minlength", v)
+// HxParamsExclude sets the hx-params attribute to include all parameters except the given parameters.
+func HxParamsExclude(names ...string) Node {
+	return Attribute(HxAttributeParams.String(), "not "+strings.Join(names, ","))
 }
 
-// Name sets the name attribute for elements.
-func Name(v string) Node {
-	return Attribute("name", v)
-}
+// HxSyncStrategy is the strategy to synchronize requests between elements.
+type HxSyncStrategy string
 
-// Pattern sets the pattern attribute for input elements.
-func Pattern(v string) Node {
-	return Attribute("pattern", v)
+// String returns the string representation of the sync strategy.
+func (s HxSyncStrategy) String() string {
+	return string(s)
 }
 
-// Placeholder sets the placeholder attribute for input elements.
-func Placeholder(v string) Node {
-	return Attribute("placeholder", v)
-}
+const (
+	HxSyncDrop       HxSyncStrategy = "drop"        // drop (ignore) this request if an existing request is in flight
+	HxSyncAbort      HxSyncStrategy = "abort"       // drop (ignore) this request if an existing request is in flight, and abort this request if another request occurs
+	HxSyncReplace    HxSyncStrategy = "replace"     // abort the current request, if any, and replace it with this request
+	HxSyncQueue      HxSyncStrategy = "queue"       // place this request in the request queue associated with the given element
+	HxSyncQueueFirst HxSyncStrategy = "queue first" // queue the first request to show up while a request is in flight
+	HxSyncQueueLast  HxSyncStrategy = "queue last"  // queue the last request to show up while a request is in flight
+	HxSyncQueueAll   HxSyncStrategy = "queue all"   // queue all requests that show up while a request is in flight
+)
 
-// Poster sets the poster attribute for video elements.
-func Poster(v string) Node {
-	return Attribute("poster", v)
+// HxSync sets the hx-sync attribute to synchronize the requests with the element selected by the selector.
+func HxSync(selector string, strategy HxSyncStrategy) Node {
+	return Attribute(HxAttributeSync.String(), selector+":"+strategy.String())
 }
 
-// Preload sets the preload attribute for media elements.
-func Preload(v string) Node {
-	return Attribute("preload", v)
+// HxHistory sets the hx-history attribute to enable or disable saving the page to the history cache.
+func HxHistory(v bool) Node {
+	return Attribute(HxAttributeHistory.String(), conv.String(v))
 }
 
-// Rel sets the rel attribute for link elements.
-func Rel(v string) Node {
-	return Attribute("rel", v)
+// HxHistoryElt sets the hx-history-elt attribute to use the element as the snapshot for the history.
+func HxHistoryElt() Node {
+	return Attribute(HxAttributeHistoryElt.String())
 }
 
-// Role sets the role attribute for elements.
-func Role(v string) Node {
-	return Attribute("role", v)
+// HxPreserve sets the hx-preserve attribute to keep the element unchanged between requests.
+func HxPreserve() Node {
+	return Attribute(HxAttributePreserve.String())
 }
 
-// Rows sets the rows attribute for textarea elements.
-func Rows(v string) Node {
-	return Attribute("rows", v)
-}
+func joinAttributes(attrs ...HxAttribute) string {
+	names := make([]string, 0, len(attrs))
+	for _, a := range attrs {
+		names = append(names, a.String())
+	}
 
-// RowSpan sets the rowspan attribute for table cells.
-func RowSpan(v string) Node {
-	return Attribute("rowspan", v)
+	return strings.Join(names, " ")
 }
 
-// Src sets the src attribute for elements.
-func Src(v string) Node {
-	return Attribute("src", v)
+// HxDisinherit sets the hx-disinherit attribute to disable the inheritance of the given attributes.
+func HxDisinherit(attrs ...HxAttribute) Node {
+	return Attribute(HxAttributeDisinherit.String(), joinAttributes(attrs...))
 }
 
-// SrcSet sets the srcset attribute for elements.
-func SrcSet(v string) Node {
-	return Attribute("srcset", v)
+// HxDisinheritAll sets the hx-disinherit attribute to disable the inheritance of all attributes.
+func HxDisinheritAll() Node {
+	return Attribute(HxAttributeDisinherit.String(), "*")
 }
 
-// Step sets the step attribute for input elements.
-func Step(v string) Node {
-	return Attribute("step", v)
+// HxInherit sets the hx-inherit attribute to enable the inheritance of the given attributes.
+func HxInherit(attrs ...HxAttribute) Node {
+	return Attribute(HxAttributeInherit.String(), joinAttributes(attrs...))
 }
 
-// StyleAttribute sets the style attribute for elements.
-func StyleAttribute(v string) Node {
-	return Attribute("style", v)
+// HxInheritAll sets the hx-inherit attribute to enable the inheritance of all attributes.
+func HxInheritAll() Node {
+	return Attribute(HxAttributeInherit.String(), "*")
 }
 
-// TabIndex sets the tabindex attribute for elements.
-func TabIndex(v string) Node {
-	return Attribute("tabindex", v)
+// HxRequestConfig is the configuration of the hx-request attribute.
+type HxRequestConfig struct {
+	// Timeout is the timeout of the request.
+	Timeout time.Duration
+	// Credentials is true if the request should send credentials.
+	Credentials bool
+	// NoHeaders is true if the request should not send the htmx headers.
+	NoHeaders bool
 }
 
-// Target sets the target attribute for elements.
-func Target(v string) Node {
-	return Attribute("target", v)
-}
+// Render renders the hx-request attribute.
+func (r HxRequestConfig) Render(w io.Writer) error {
+	v := struct {
+		Timeout     int64 `json:"timeout,omitempty"`
+		Credentials bool  `json:"credentials,omitempty"`
+		NoHeaders   bool  `json:"noHeaders,omitempty"`
+	}{
+		Timeout:     r.Timeout.Milliseconds(),
+		Credentials: r.Credentials,
+		NoHeaders:   r.NoHeaders,
+	}
 
-// TitleAttribute sets the title attribute for elements.
-func TitleAttribute(v string) Node {
-	return Attribute("title", v)
-}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
 
-// Type sets the type attribute for elements.
-func Type(v string) Node {
-	return Attribute("type", v)
+	return Attribute(HxAttributeRequest.String(), string(b)).Render(w)
 }
 
-// Value sets the value attribute for elements.
-func Value(v string) Node {
-	return Attribute("value", v)
+// Type returns the node type of the HxRequestConfig.
+func (r HxRequestConfig) Type() NodeType {
+	return AttributeType
 }
 
-// Width sets the width attribute for elements.
-func Width(v string) Node {
-	return Attribute("width", v)
+// Aria sets the aria-{name} attribute for elements.
+func Aria(name, v string) Node {
+	return Attribute("aria-"+name, v)
 }
 
-// EncType sets the enctype attribute for form elements.
-func EncType(v string) Node {
-	return Attribute("enctype", v)
+// DataAttribute sets the data-{name} attribute for elements.
+func DataAttribute(name, v string) Node {
+	return Attribute("data-"+name, v)
 }
 
 // OnClick sets the onclick attribute for elements.
@@ -658,37 +586,7 @@ func OnClick(v string) Node {
 	return Attribute("onclick", v)
 }
 
-// Is sets the is attribute for custom elements.
-func Is(v string) Node {
-	return Attribute("is", v)
-}
-
-// Integrity sets the integrity attribute for elements.
-func Integrity(v string) Node {
-	return Attribute("integrity", v)
-}
-
-// NoValidate sets the novalidate attribute for form elements.
-func NoValidate() Node {
-	return Attribute("novalidate")
-}
-
-// CrossOrigin sets the crossorigin attribute for elements.
-func CrossOrigin(v string) Node {
-	return Attribute("crossorigin", v)
-}
-
-// List sets the list attribute for input elements.
-func List(v string) Node {
-	return Attribute("list", v)
-}
-
 // OnePasswordIgnore sets the data-1p-ignore attribute for elements.
 func OnePasswordIgnore() Node {
 	return Attribute("data-1p-ignore")
 }
-
-// ContentEditable sets the contenteditable attribute for elements.
-func ContentEditable(v string) Node {
-	return Attribute("contenteditable", v)
-}
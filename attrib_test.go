@@ -2,6 +2,7 @@ package htmx_test
 
 import (
 	"testing"
+	"time"
 
 	htmx "github.com/zeiss/fiber-htmx"
 	"github.com/zeiss/fiber-htmx/internal/assert"
@@ -179,7 +180,7 @@ func Test_HxTrigger(t *testing.T) {
 		},
 		{
 			name:  "hx-trigger",
-			want:  " hx-trigger=\"click onload\"",
+			want:  " hx-trigger=\"click load\"",
 			event: []string{htmx.TriggerClick, htmx.TriggerLoad},
 		},
 	}
@@ -236,3 +237,209 @@ func Test_HxIndicator(t *testing.T) {
 		})
 	}
 }
+
+func Test_Popover(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		v    htmx.PopoverValue
+		want string
+	}{
+		{
+			name: "auto",
+			v:    htmx.PopoverAuto,
+			want: ` popover="auto"`,
+		},
+		{
+			name: "manual",
+			v:    htmx.PopoverManual,
+			want: ` popover="manual"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, htmx.Popover(test.v))
+		})
+	}
+}
+
+func Test_InputMode(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, ` inputmode="numeric"`, htmx.InputMode(htmx.InputModeNumeric))
+	assert.Equal(t, ` enterkeyhint="send"`, htmx.EnterKeyHint(htmx.EnterKeyHintSend))
+	assert.Equal(t, ` inert`, htmx.Inert())
+	assert.Equal(t, ` spellcheck="false"`, htmx.SpellCheck(false))
+	assert.Equal(t, ` autocorrect="off"`, htmx.AutoCorrect(htmx.AutoCorrectOff))
+	assert.Equal(t, ` part="label"`, htmx.Part("label"))
+	assert.Equal(t, ` exportparts="label"`, htmx.ExportParts("label"))
+	assert.Equal(t, ` abbr="Qty"`, htmx.AbbrAttribute("Qty"))
+	assert.Equal(t, ` allowfullscreen`, htmx.AllowFullscreen())
+}
+
+func Test_Aria(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		node htmx.Node
+		want string
+	}{
+		{
+			name: "expanded",
+			node: htmx.AriaExpanded(true),
+			want: ` aria-expanded="true"`,
+		},
+		{
+			name: "controls",
+			node: htmx.AriaControls("menu", "submenu"),
+			want: ` aria-controls="menu submenu"`,
+		},
+		{
+			name: "checked",
+			node: htmx.AriaChecked(htmx.AriaCheckedMixed),
+			want: ` aria-checked="mixed"`,
+		},
+		{
+			name: "level",
+			node: htmx.AriaLevel(2),
+			want: ` aria-level="2"`,
+		},
+		{
+			name: "valuenow",
+			node: htmx.AriaValueNow(0.5),
+			want: ` aria-valuenow="0.5"`,
+		},
+		{
+			name: "relevant",
+			node: htmx.AriaRelevant(htmx.AriaRelevantAdditions, htmx.AriaRelevantText),
+			want: ` aria-relevant="additions text"`,
+		},
+		{
+			name: "role",
+			node: htmx.RoleDialog(),
+			want: ` role="dialog"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.node)
+		})
+	}
+}
+
+func Test_HxPushUrl(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, ` hx-push-url="true"`, htmx.HxPushUrl(true))
+	assert.Equal(t, ` hx-push-url="/projects"`, htmx.HxPushUrlTo("/projects"))
+	assert.Equal(t, ` hx-replace-url="false"`, htmx.HxReplaceUrl(false))
+}
+
+func Test_HxValsParamsSync(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		node htmx.Node
+		want string
+	}{
+		{
+			name: "hx-vals",
+			node: htmx.HxVals(map[string]any{"id": 1}),
+			want: ` hx-vals="{&#34;id&#34;:1}"`,
+		},
+		{
+			name: "hx-vals js",
+			node: htmx.HxValsJS("{lastKey: event.key}"),
+			want: ` hx-vals="js:{lastKey: event.key}"`,
+		},
+		{
+			name: "hx-vars",
+			node: htmx.HxVars(map[string]string{"b": "2", "a": "window.a"}),
+			want: ` hx-vars="a:window.a, b:2"`,
+		},
+		{
+			name: "hx-params include",
+			node: htmx.HxParamsInclude("a", "b"),
+			want: ` hx-params="a,b"`,
+		},
+		{
+			name: "hx-params exclude",
+			node: htmx.HxParamsExclude("a"),
+			want: ` hx-params="not a"`,
+		},
+		{
+			name: "hx-sync",
+			node: htmx.HxSync("closest form", htmx.HxSyncQueueLast),
+			want: ` hx-sync="closest form:queue last"`,
+		},
+		{
+			name: "hx-disinherit",
+			node: htmx.HxDisinherit(htmx.HxAttributeTarget, htmx.HxAttributeSwap),
+			want: ` hx-disinherit="hx-target hx-swap"`,
+		},
+		{
+			name: "hx-request",
+			node: htmx.HxRequestConfig{Timeout: time.Second, NoHeaders: true},
+			want: ` hx-request="{&#34;timeout&#34;:1000,&#34;noHeaders&#34;:true}"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.node)
+		})
+	}
+}
+
+func Test_HxOnEvent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		node htmx.Node
+		want string
+	}{
+		{
+			name: "after request",
+			node: htmx.HxOnEvent(htmx.HxEventTypeAfterRequest, "this.reset()"),
+			want: ` hx-on::after-request="this.reset()"`,
+		},
+		{
+			name: "validation failed",
+			node: htmx.HxOnEvent(htmx.HxEventTypeValidationFailedEvent, "alert(1)"),
+			want: ` hx-on::validation:failed="alert(1)"`,
+		},
+		{
+			name: "oob error",
+			node: htmx.HxOnEvent(htmx.HxEventTypeOobErrorNoTargetEvent, "a()", "b()"),
+			want: ` hx-on::oob-error-no-target="a(); b()"`,
+		},
+		{
+			name: "click",
+			node: htmx.OnDOM(htmx.JSEventTypeClickEvent, "this.remove()"),
+			want: ` hx-on:click="this.remove()"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.node)
+		})
+	}
+}
+
+func Test_HxOnEventMultiple(t *testing.T) {
+	t.Parallel()
+
+	e := htmx.Button(
+		htmx.HxOnEvent(htmx.HxEventTypeBeforeRequest, "a()"),
+		htmx.OnDOM(htmx.JSEventTypeClickEvent, "b()"),
+	)
+
+	assert.Equal(t, `<button hx-on::before-request="a()" hx-on:click="b()"></button>`, e)
+}
@@ -0,0 +1,1037 @@
+// Code generated by github.com/zeiss/fiber-htmx/cmd/html. DO NOT EDIT.
+
+package htmx
+
+import (
+	"github.com/zeiss/pkg/conv"
+)
+
+// AccessKey sets the accesskey attribute for elements.
+func AccessKey(v string) Node {
+	return Attribute("accesskey", v)
+}
+
+// AutoCapitalizeValue is a value of the autocapitalize attribute.
+type AutoCapitalizeValue string
+
+// String returns the string representation of the AutoCapitalizeValue.
+func (v AutoCapitalizeValue) String() string {
+	return string(v)
+}
+
+// List of values of the autocapitalize attribute.
+const (
+	AutoCapitalizeOff        AutoCapitalizeValue = "off"
+	AutoCapitalizeNone       AutoCapitalizeValue = "none"
+	AutoCapitalizeOn         AutoCapitalizeValue = "on"
+	AutoCapitalizeSentences  AutoCapitalizeValue = "sentences"
+	AutoCapitalizeWords      AutoCapitalizeValue = "words"
+	AutoCapitalizeCharacters AutoCapitalizeValue = "characters"
+)
+
+// AutoCapitalize sets the autocapitalize attribute for elements.
+func AutoCapitalize(v AutoCapitalizeValue) Node {
+	return Attribute("autocapitalize", v.String())
+}
+
+// AutoCorrectValue is a value of the autocorrect attribute.
+type AutoCorrectValue string
+
+// String returns the string representation of the AutoCorrectValue.
+func (v AutoCorrectValue) String() string {
+	return string(v)
+}
+
+// List of values of the autocorrect attribute.
+const (
+	AutoCorrectOn  AutoCorrectValue = "on"
+	AutoCorrectOff AutoCorrectValue = "off"
+)
+
+// AutoCorrect sets the autocorrect attribute for elements.
+func AutoCorrect(v AutoCorrectValue) Node {
+	return Attribute("autocorrect", v.String())
+}
+
+// AutoFocus sets the autofocus attribute for form elements.
+func AutoFocus() Node {
+	return Attribute("autofocus")
+}
+
+// Class sets the class attribute for elements.
+func Class(v string) Node {
+	return Attribute("class", v)
+}
+
+// ContentEditable sets the contenteditable attribute for elements.
+func ContentEditable(v string) Node {
+	return Attribute("contenteditable", v)
+}
+
+// DirValue is a value of the dir attribute.
+type DirValue string
+
+// String returns the string representation of the DirValue.
+func (v DirValue) String() string {
+	return string(v)
+}
+
+// List of values of the dir attribute.
+const (
+	DirLtr  DirValue = "ltr"
+	DirRtl  DirValue = "rtl"
+	DirAuto DirValue = "auto"
+)
+
+// Dir sets the dir attribute for elements.
+func Dir(v DirValue) Node {
+	return Attribute("dir", v.String())
+}
+
+// Draggable sets the draggable attribute for elements.
+func Draggable(v bool) Node {
+	return Attribute("draggable", conv.String(v))
+}
+
+// EnterKeyHintValue is a value of the enterkeyhint attribute.
+type EnterKeyHintValue string
+
+// String returns the string representation of the EnterKeyHintValue.
+func (v EnterKeyHintValue) String() string {
+	return string(v)
+}
+
+// List of values of the enterkeyhint attribute.
+const (
+	EnterKeyHintEnter    EnterKeyHintValue = "enter"
+	EnterKeyHintDone     EnterKeyHintValue = "done"
+	EnterKeyHintGo       EnterKeyHintValue = "go"
+	EnterKeyHintNext     EnterKeyHintValue = "next"
+	EnterKeyHintPrevious EnterKeyHintValue = "previous"
+	EnterKeyHintSearch   EnterKeyHintValue = "search"
+	EnterKeyHintSend     EnterKeyHintValue = "send"
+)
+
+// EnterKeyHint sets the enterkeyhint attribute for elements.
+func EnterKeyHint(v EnterKeyHintValue) Node {
+	return Attribute("enterkeyhint", v.String())
+}
+
+// ExportParts sets the exportparts attribute for elements.
+func ExportParts(v string) Node {
+	return Attribute("exportparts", v)
+}
+
+// Hidden sets the hidden attribute for elements.
+func Hidden() Node {
+	return Attribute("hidden")
+}
+
+// ID sets the id attribute for elements.
+func ID(v string) Node {
+	return Attribute("id", v)
+}
+
+// Inert sets the inert attribute for elements.
+func Inert() Node {
+	return Attribute("inert")
+}
+
+// InputModeValue is a value of the inputmode attribute.
+type InputModeValue string
+
+// String returns the string representation of the InputModeValue.
+func (v InputModeValue) String() string {
+	return string(v)
+}
+
+// List of values of the inputmode attribute.
+const (
+	InputModeNone    InputModeValue = "none"
+	InputModeText    InputModeValue = "text"
+	InputModeTel     InputModeValue = "tel"
+	InputModeUrl     InputModeValue = "url"
+	InputModeEmail   InputModeValue = "email"
+	InputModeNumeric InputModeValue = "numeric"
+	InputModeDecimal InputModeValue = "decimal"
+	InputModeSearch  InputModeValue = "search"
+)
+
+// InputMode sets the inputmode attribute for elements.
+func InputMode(v InputModeValue) Node {
+	return Attribute("inputmode", v.String())
+}
+
+// Is sets the is attribute for custom elements.
+func Is(v string) Node {
+	return Attribute("is", v)
+}
+
+// ItemID sets the itemid attribute for elements.
+func ItemID(v string) Node {
+	return Attribute("itemid", v)
+}
+
+// ItemProp sets the itemprop attribute for elements.
+func ItemProp(v string) Node {
+	return Attribute("itemprop", v)
+}
+
+// ItemRef sets the itemref attribute for elements.
+func ItemRef(v string) Node {
+	return Attribute("itemref", v)
+}
+
+// ItemScope sets the itemscope attribute for elements.
+func ItemScope() Node {
+	return Attribute("itemscope")
+}
+
+// ItemType sets the itemtype attribute for elements.
+func ItemType(v string) Node {
+	return Attribute("itemtype", v)
+}
+
+// Lang sets the lang attribute for elements.
+func Lang(v string) Node {
+	return Attribute("lang", v)
+}
+
+// Nonce sets the nonce attribute for elements.
+func Nonce(v string) Node {
+	return Attribute("nonce", v)
+}
+
+// Part sets the part attribute for elements.
+func Part(v string) Node {
+	return Attribute("part", v)
+}
+
+// PopoverValue is a value of the popover attribute.
+type PopoverValue string
+
+// String returns the string representation of the PopoverValue.
+func (v PopoverValue) String() string {
+	return string(v)
+}
+
+// List of values of the popover attribute.
+const (
+	PopoverAuto   PopoverValue = "auto"
+	PopoverManual PopoverValue = "manual"
+	PopoverHint   PopoverValue = "hint"
+)
+
+// Popover sets the popover attribute for elements.
+func Popover(v PopoverValue) Node {
+	return Attribute("popover", v.String())
+}
+
+// Role sets the role attribute for elements.
+func Role(v string) Node {
+	return Attribute("role", v)
+}
+
+// SlotAttribute sets the slot attribute for elements.
+func SlotAttribute(v string) Node {
+	return Attribute("slot", v)
+}
+
+// SpellCheck sets the spellcheck attribute for elements.
+func SpellCheck(v bool) Node {
+	return Attribute("spellcheck", conv.String(v))
+}
+
+// StyleAttribute sets the style attribute for elements.
+func StyleAttribute(v string) Node {
+	return Attribute("style", v)
+}
+
+// TabIndex sets the tabindex attribute for elements.
+func TabIndex(v string) Node {
+	return Attribute("tabindex", v)
+}
+
+// TitleAttribute sets the title attribute for elements.
+func TitleAttribute(v string) Node {
+	return Attribute("title", v)
+}
+
+// TranslateValue is a value of the translate attribute.
+type TranslateValue string
+
+// String returns the string representation of the TranslateValue.
+func (v TranslateValue) String() string {
+	return string(v)
+}
+
+// List of values of the translate attribute.
+const (
+	TranslateYes TranslateValue = "yes"
+	TranslateNo  TranslateValue = "no"
+)
+
+// Translate sets the translate attribute for elements.
+func Translate(v TranslateValue) Node {
+	return Attribute("translate", v.String())
+}
+
+// WritingSuggestions sets the writingsuggestions attribute for elements.
+func WritingSuggestions(v bool) Node {
+	return Attribute("writingsuggestions", conv.String(v))
+}
+
+// AbbrAttribute sets the abbr attribute for table header cells.
+// It applies to the th elements.
+func AbbrAttribute(v string) Node {
+	return Attribute("abbr", v)
+}
+
+// Accept sets the accept attribute for file input elements.
+// It applies to the input elements.
+func Accept(v string) Node {
+	return Attribute("accept", v)
+}
+
+// AcceptCharset sets the accept-charset attribute for form elements.
+// It applies to the form elements.
+func AcceptCharset(v string) Node {
+	return Attribute("accept-charset", v)
+}
+
+// Action sets the action attribute for form elements.
+// It applies to the form elements.
+func Action(v string) Node {
+	return Attribute("action", v)
+}
+
+// Allow sets the allow attribute for iframe elements.
+// It applies to the iframe elements.
+func Allow(v string) Node {
+	return Attribute("allow", v)
+}
+
+// AllowFullscreen sets the allowfullscreen attribute for iframe elements.
+// It applies to the iframe elements.
+func AllowFullscreen() Node {
+	return Attribute("allowfullscreen")
+}
+
+// Alt sets the alt attribute for image elements.
+// It applies to the area, img and input elements.
+func Alt(v string) Node {
+	return Attribute("alt", v)
+}
+
+// As sets the as attribute for link elements.
+// It applies to the link elements.
+func As(v string) Node {
+	return Attribute("as", v)
+}
+
+// Async sets the async attribute for script elements.
+// It applies to the script elements.
+func Async() Node {
+	return Attribute("async")
+}
+
+// AutoComplete sets the autocomplete attribute for form elements.
+// It applies to the form, input, select and textarea elements.
+func AutoComplete(v string) Node {
+	return Attribute("autocomplete", v)
+}
+
+// AutoPlay sets the autoplay attribute for media elements.
+// It applies to the audio and video elements.
+func AutoPlay() Node {
+	return Attribute("autoplay")
+}
+
+// Blocking sets the blocking attribute for link, script and style elements.
+// It applies to the link, script and style elements.
+func Blocking(v string) Node {
+	return Attribute("blocking", v)
+}
+
+// Charset sets the charset attribute for meta elements.
+// It applies to the meta elements.
+func Charset(v string) Node {
+	return Attribute("charset", v)
+}
+
+// Checked sets the checked attribute for input elements.
+// It applies to the input elements.
+func Checked() Node {
+	return Attribute("checked")
+}
+
+// CiteAttribute sets the cite attribute for quotation and edit elements.
+// It applies to the blockquote, del, ins and q elements.
+func CiteAttribute(v string) Node {
+	return Attribute("cite", v)
+}
+
+// Cols sets the cols attribute for textarea elements.
+// It applies to the textarea elements.
+func Cols(v string) Node {
+	return Attribute("cols", v)
+}
+
+// ColSpan sets the colspan attribute for table cells.
+// It applies to the td and th elements.
+func ColSpan(v string) Node {
+	return Attribute("colspan", v)
+}
+
+// Content sets the content attribute for meta elements.
+// It applies to the meta elements.
+func Content(v string) Node {
+	return Attribute("content", v)
+}
+
+// Controls sets the controls attribute for media elements.
+// It applies to the audio and video elements.
+func Controls() Node {
+	return Attribute("controls")
+}
+
+// Coords sets the coords attribute for area elements.
+// It applies to the area elements.
+func Coords(v string) Node {
+	return Attribute("coords", v)
+}
+
+// CrossOrigin sets the crossorigin attribute for elements.
+// It applies to the audio, img, link, script and video elements.
+func CrossOrigin(v string) Node {
+	return Attribute("crossorigin", v)
+}
+
+// ObjectData sets the data attribute for object elements.
+// It applies to the object elements.
+func ObjectData(v string) Node {
+	return Attribute("data", v)
+}
+
+// DateTime sets the datetime attribute for time and edit elements.
+// It applies to the del, ins and time elements.
+func DateTime(v string) Node {
+	return Attribute("datetime", v)
+}
+
+// DecodingValue is a value of the decoding attribute.
+type DecodingValue string
+
+// String returns the string representation of the DecodingValue.
+func (v DecodingValue) String() string {
+	return string(v)
+}
+
+// List of values of the decoding attribute.
+const (
+	DecodingSync  DecodingValue = "sync"
+	DecodingAsync DecodingValue = "async"
+	DecodingAuto  DecodingValue = "auto"
+)
+
+// Decoding sets the decoding attribute for image elements.
+// It applies to the img elements.
+func Decoding(v DecodingValue) Node {
+	return Attribute("decoding", v.String())
+}
+
+// Default sets the default attribute for track elements.
+// It applies to the track elements.
+func Default() Node {
+	return Attribute("default")
+}
+
+// Defer sets the defer attribute for script elements.
+// It applies to the script elements.
+func Defer() Node {
+	return Attribute("defer")
+}
+
+// DirName sets the dirname attribute for form elements.
+// It applies to the input and textarea elements.
+func DirName(v string) Node {
+	return Attribute("dirname", v)
+}
+
+// Disabled sets the disabled attribute for form elements.
+// It applies to the button, fieldset, input, optgroup, option, select and textarea elements.
+func Disabled() Node {
+	return Attribute("disabled")
+}
+
+// Download sets the download attribute for anchor elements.
+// It applies to the a and area elements.
+func Download(v string) Node {
+	return Attribute("download", v)
+}
+
+// EncType sets the enctype attribute for form elements.
+// It applies to the form elements.
+func EncType(v string) Node {
+	return Attribute("enctype", v)
+}
+
+// FetchPriorityValue is a value of the fetchpriority attribute.
+type FetchPriorityValue string
+
+// String returns the string representation of the FetchPriorityValue.
+func (v FetchPriorityValue) String() string {
+	return string(v)
+}
+
+// List of values of the fetchpriority attribute.
+const (
+	FetchPriorityHigh FetchPriorityValue = "high"
+	FetchPriorityLow  FetchPriorityValue = "low"
+	FetchPriorityAuto FetchPriorityValue = "auto"
+)
+
+// FetchPriority sets the fetchpriority attribute for elements.
+// It applies to the img, link and script elements.
+func FetchPriority(v FetchPriorityValue) Node {
+	return Attribute("fetchpriority", v.String())
+}
+
+// For sets the for attribute for label elements.
+// It applies to the label and output elements.
+func For(v string) Node {
+	return Attribute("for", v)
+}
+
+// FormAttribute sets the form attribute for elements.
+// It applies to the button, fieldset, input, object, output, select and textarea elements.
+func FormAttribute(v string) Node {
+	return Attribute("form", v)
+}
+
+// FormAction sets the formaction attribute for submit elements.
+// It applies to the button and input elements.
+func FormAction(v string) Node {
+	return Attribute("formaction", v)
+}
+
+// FormEncType sets the formenctype attribute for submit elements.
+// It applies to the button and input elements.
+func FormEncType(v string) Node {
+	return Attribute("formenctype", v)
+}
+
+// FormMethod sets the formmethod attribute for submit elements.
+// It applies to the button and input elements.
+func FormMethod(v string) Node {
+	return Attribute("formmethod", v)
+}
+
+// FormNoValidate sets the formnovalidate attribute for submit elements.
+// It applies to the button and input elements.
+func FormNoValidate() Node {
+	return Attribute("formnovalidate")
+}
+
+// FormTarget sets the formtarget attribute for submit elements.
+// It applies to the button and input elements.
+func FormTarget(v string) Node {
+	return Attribute("formtarget", v)
+}
+
+// Headers sets the headers attribute for table cells.
+// It applies to the td and th elements.
+func Headers(v string) Node {
+	return Attribute("headers", v)
+}
+
+// Height sets the height attribute for elements.
+// It applies to the canvas, embed, iframe, img, input, object, source and video elements.
+func Height(v string) Node {
+	return Attribute("height", v)
+}
+
+// High sets the high attribute for meter elements.
+// It applies to the meter elements.
+func High(v string) Node {
+	return Attribute("high", v)
+}
+
+// Href sets the href attribute for anchor elements.
+// It applies to the a, area, base and link elements.
+func Href(v string) Node {
+	return Attribute("href", v)
+}
+
+// HrefLang sets the hreflang attribute for anchor elements.
+// It applies to the a and link elements.
+func HrefLang(v string) Node {
+	return Attribute("hreflang", v)
+}
+
+// HTTPEquiv sets the http-equiv attribute for meta elements.
+// It applies to the meta elements.
+func HTTPEquiv(v string) Node {
+	return Attribute("http-equiv", v)
+}
+
+// ImageSizes sets the imagesizes attribute for link elements.
+// It applies to the link elements.
+func ImageSizes(v string) Node {
+	return Attribute("imagesizes", v)
+}
+
+// ImageSrcSet sets the imagesrcset attribute for link elements.
+// It applies to the link elements.
+func ImageSrcSet(v string) Node {
+	return Attribute("imagesrcset", v)
+}
+
+// Integrity sets the integrity attribute for elements.
+// It applies to the link and script elements.
+func Integrity(v string) Node {
+	return Attribute("integrity", v)
+}
+
+// IsMap sets the ismap attribute for image elements.
+// It applies to the img elements.
+func IsMap() Node {
+	return Attribute("ismap")
+}
+
+// KindValue is a value of the kind attribute.
+type KindValue string
+
+// String returns the string representation of the KindValue.
+func (v KindValue) String() string {
+	return string(v)
+}
+
+// List of values of the kind attribute.
+const (
+	KindSubtitles    KindValue = "subtitles"
+	KindCaptions     KindValue = "captions"
+	KindDescriptions KindValue = "descriptions"
+	KindChapters     KindValue = "chapters"
+	KindMetadata     KindValue = "metadata"
+)
+
+// Kind sets the kind attribute for track elements.
+// It applies to the track elements.
+func Kind(v KindValue) Node {
+	return Attribute("kind", v.String())
+}
+
+// LabelAttribute sets the label attribute for option and track elements.
+// It applies to the optgroup, option and track elements.
+func LabelAttribute(v string) Node {
+	return Attribute("label", v)
+}
+
+// List sets the list attribute for input elements.
+// It applies to the input elements.
+func List(v string) Node {
+	return Attribute("list", v)
+}
+
+// Loading sets the loading attribute for elements.
+// It applies to the iframe and img elements.
+func Loading(v string) Node {
+	return Attribute("loading", v)
+}
+
+// Loop sets the loop attribute for media elements.
+// It applies to the audio and video elements.
+func Loop() Node {
+	return Attribute("loop")
+}
+
+// Low sets the low attribute for meter elements.
+// It applies to the meter elements.
+func Low(v string) Node {
+	return Attribute("low", v)
+}
+
+// Max sets the max attribute for input elements.
+// It applies to the input, meter and progress elements.
+func Max(v string) Node {
+	return Attribute("max", v)
+}
+
+// MaxLength sets the maxlength attribute for input elements.
+// It applies to the input and textarea elements.
+func MaxLength(v string) Node {
+	return Attribute("maxlength", v)
+}
+
+// Media sets the media attribute for link, meta, source and style elements.
+// It applies to the link, meta, source and style elements.
+func Media(v string) Node {
+	return Attribute("media", v)
+}
+
+// Method sets the method attribute for form elements.
+// It applies to the form elements.
+func Method(v string) Node {
+	return Attribute("method", v)
+}
+
+// Min sets the min attribute for input elements.
+// It applies to the input and meter elements.
+func Min(v string) Node {
+	return Attribute("min", v)
+}
+
+// MinLength sets the minlength attribute for input elements.
+// It applies to the input and textarea elements.
+func MinLength(v string) Node {
+	return Attribute("minlength", v)
+}
+
+// Multiple sets the multiple attribute for input elements.
+// It applies to the input and select elements.
+func Multiple() Node {
+	return Attribute("multiple")
+}
+
+// Muted sets the muted attribute for media elements.
+// It applies to the audio and video elements.
+func Muted() Node {
+	return Attribute("muted")
+}
+
+// Name sets the name attribute for elements.
+// It applies to the button, fieldset, form, iframe, input, map, meta, object, output, param, select, slot and textarea elements.
+func Name(v string) Node {
+	return Attribute("name", v)
+}
+
+// NoModule sets the nomodule attribute for script elements.
+// It applies to the script elements.
+func NoModule() Node {
+	return Attribute("nomodule")
+}
+
+// NoValidate sets the novalidate attribute for form elements.
+// It applies to the form elements.
+func NoValidate() Node {
+	return Attribute("novalidate")
+}
+
+// Open sets the open attribute for details and dialog elements.
+// It applies to the details and dialog elements.
+func Open() Node {
+	return Attribute("open")
+}
+
+// Optimum sets the optimum attribute for meter elements.
+// It applies to the meter elements.
+func Optimum(v string) Node {
+	return Attribute("optimum", v)
+}
+
+// Pattern sets the pattern attribute for input elements.
+// It applies to the input elements.
+func Pattern(v string) Node {
+	return Attribute("pattern", v)
+}
+
+// Ping sets the ping attribute for anchor elements.
+// It applies to the a and area elements.
+func Ping(v string) Node {
+	return Attribute("ping", v)
+}
+
+// Placeholder sets the placeholder attribute for input elements.
+// It applies to the input and textarea elements.
+func Placeholder(v string) Node {
+	return Attribute("placeholder", v)
+}
+
+// PlaysInline sets the playsinline attribute for media elements.
+// It applies to the video elements.
+func PlaysInline() Node {
+	return Attribute("playsinline")
+}
+
+// PopoverTarget sets the popovertarget attribute for button elements.
+// It applies to the button and input elements.
+func PopoverTarget(v string) Node {
+	return Attribute("popovertarget", v)
+}
+
+// PopoverTargetActionValue is a value of the popovertargetaction attribute.
+type PopoverTargetActionValue string
+
+// String returns the string representation of the PopoverTargetActionValue.
+func (v PopoverTargetActionValue) String() string {
+	return string(v)
+}
+
+// List of values of the popovertargetaction attribute.
+const (
+	PopoverTargetActionToggle PopoverTargetActionValue = "toggle"
+	PopoverTargetActionShow   PopoverTargetActionValue = "show"
+	PopoverTargetActionHide   PopoverTargetActionValue = "hide"
+)
+
+// PopoverTargetAction sets the popovertargetaction attribute for button elements.
+// It applies to the button and input elements.
+func PopoverTargetAction(v PopoverTargetActionValue) Node {
+	return Attribute("popovertargetaction", v.String())
+}
+
+// Poster sets the poster attribute for video elements.
+// It applies to the video elements.
+func Poster(v string) Node {
+	return Attribute("poster", v)
+}
+
+// Preload sets the preload attribute for media elements.
+// It applies to the audio and video elements.
+func Preload(v string) Node {
+	return Attribute("preload", v)
+}
+
+// ReadOnly sets the readonly attribute for form elements.
+// It applies to the input and textarea elements.
+func ReadOnly() Node {
+	return Attribute("readonly")
+}
+
+// ReferrerPolicyValue is a value of the referrerpolicy attribute.
+type ReferrerPolicyValue string
+
+// String returns the string representation of the ReferrerPolicyValue.
+func (v ReferrerPolicyValue) String() string {
+	return string(v)
+}
+
+// List of values of the referrerpolicy attribute.
+const (
+	ReferrerPolicyNoReferrer                  ReferrerPolicyValue = "no-referrer"
+	ReferrerPolicyNoReferrerWhenDowngrade     ReferrerPolicyValue = "no-referrer-when-downgrade"
+	ReferrerPolicySameOrigin                  ReferrerPolicyValue = "same-origin"
+	ReferrerPolicyOrigin                      ReferrerPolicyValue = "origin"
+	ReferrerPolicyStrictOrigin                ReferrerPolicyValue = "strict-origin"
+	ReferrerPolicyOriginWhenCrossOrigin       ReferrerPolicyValue = "origin-when-cross-origin"
+	ReferrerPolicyStrictOriginWhenCrossOrigin ReferrerPolicyValue = "strict-origin-when-cross-origin"
+	ReferrerPolicyUnsafeUrl                   ReferrerPolicyValue = "unsafe-url"
+)
+
+// ReferrerPolicy sets the referrerpolicy attribute for elements.
+// It applies to the a, area, iframe, img, link and script elements.
+func ReferrerPolicy(v ReferrerPolicyValue) Node {
+	return Attribute("referrerpolicy", v.String())
+}
+
+// Rel sets the rel attribute for link elements.
+// It applies to the a, area, form and link elements.
+func Rel(v string) Node {
+	return Attribute("rel", v)
+}
+
+// Required sets the required attribute for form elements.
+// It applies to the input, select and textarea elements.
+func Required() Node {
+	return Attribute("required")
+}
+
+// Reversed sets the reversed attribute for ordered list elements.
+// It applies to the ol elements.
+func Reversed() Node {
+	return Attribute("reversed")
+}
+
+// Rows sets the rows attribute for textarea elements.
+// It applies to the textarea elements.
+func Rows(v string) Node {
+	return Attribute("rows", v)
+}
+
+// RowSpan sets the rowspan attribute for table cells.
+// It applies to the td and th elements.
+func RowSpan(v string) Node {
+	return Attribute("rowspan", v)
+}
+
+// Sandbox sets the sandbox attribute for iframe elements.
+// It applies to the iframe elements.
+func Sandbox(v string) Node {
+	return Attribute("sandbox", v)
+}
+
+// ScopeValue is a value of the scope attribute.
+type ScopeValue string
+
+// String returns the string representation of the ScopeValue.
+func (v ScopeValue) String() string {
+	return string(v)
+}
+
+// List of values of the scope attribute.
+const (
+	ScopeRow      ScopeValue = "row"
+	ScopeCol      ScopeValue = "col"
+	ScopeRowgroup ScopeValue = "rowgroup"
+	ScopeColgroup ScopeValue = "colgroup"
+)
+
+// Scope sets the scope attribute for table header cells.
+// It applies to the th elements.
+func Scope(v ScopeValue) Node {
+	return Attribute("scope", v.String())
+}
+
+// Selected sets the selected attribute for option elements.
+// It applies to the option elements.
+func Selected() Node {
+	return Attribute("selected")
+}
+
+// ShadowRootModeValue is a value of the shadowrootmode attribute.
+type ShadowRootModeValue string
+
+// String returns the string representation of the ShadowRootModeValue.
+func (v ShadowRootModeValue) String() string {
+	return string(v)
+}
+
+// List of values of the shadowrootmode attribute.
+const (
+	ShadowRootModeOpen   ShadowRootModeValue = "open"
+	ShadowRootModeClosed ShadowRootModeValue = "closed"
+)
+
+// ShadowRootMode sets the shadowrootmode attribute for template elements.
+// It applies to the template elements.
+func ShadowRootMode(v ShadowRootModeValue) Node {
+	return Attribute("shadowrootmode", v.String())
+}
+
+// ShapeValue is a value of the shape attribute.
+type ShapeValue string
+
+// String returns the string representation of the ShapeValue.
+func (v ShapeValue) String() string {
+	return string(v)
+}
+
+// List of values of the shape attribute.
+const (
+	ShapeRect    ShapeValue = "rect"
+	ShapeCircle  ShapeValue = "circle"
+	ShapePoly    ShapeValue = "poly"
+	ShapeDefault ShapeValue = "default"
+)
+
+// Shape sets the shape attribute for area elements.
+// It applies to the area elements.
+func Shape(v ShapeValue) Node {
+	return Attribute("shape", v.String())
+}
+
+// Size sets the size attribute for input and select elements.
+// It applies to the input and select elements.
+func Size(v string) Node {
+	return Attribute("size", v)
+}
+
+// Sizes sets the sizes attribute for image elements.
+// It applies to the img, link and source elements.
+func Sizes(v string) Node {
+	return Attribute("sizes", v)
+}
+
+// SpanAttribute sets the span attribute for column elements.
+// It applies to the col and colgroup elements.
+func SpanAttribute(v string) Node {
+	return Attribute("span", v)
+}
+
+// Src sets the src attribute for elements.
+// It applies to the audio, embed, iframe, img, input, script, source, track and video elements.
+func Src(v string) Node {
+	return Attribute("src", v)
+}
+
+// SrcDoc sets the srcdoc attribute for iframe elements.
+// It applies to the iframe elements.
+func SrcDoc(v string) Node {
+	return Attribute("srcdoc", v)
+}
+
+// SrcLang sets the srclang attribute for track elements.
+// It applies to the track elements.
+func SrcLang(v string) Node {
+	return Attribute("srclang", v)
+}
+
+// SrcSet sets the srcset attribute for elements.
+// It applies to the img and source elements.
+func SrcSet(v string) Node {
+	return Attribute("srcset", v)
+}
+
+// Start sets the start attribute for ordered list elements.
+// It applies to the ol elements.
+func Start(v string) Node {
+	return Attribute("start", v)
+}
+
+// Step sets the step attribute for input elements.
+// It applies to the input elements.
+func Step(v string) Node {
+	return Attribute("step", v)
+}
+
+// Target sets the target attribute for elements.
+// It applies to the a, area, base and form elements.
+func Target(v string) Node {
+	return Attribute("target", v)
+}
+
+// Type sets the type attribute for elements.
+// It applies to the a, button, embed, input, link, object, ol, script, source and style elements.
+func Type(v string) Node {
+	return Attribute("type", v)
+}
+
+// UseMap sets the usemap attribute for image elements.
+// It applies to the img elements.
+func UseMap(v string) Node {
+	return Attribute("usemap", v)
+}
+
+// Value sets the value attribute for elements.
+// It applies to the button, data, input, li, meter, option, param and progress elements.
+func Value(v string) Node {
+	return Attribute("value", v)
+}
+
+// Width sets the width attribute for elements.
+// It applies to the canvas, embed, iframe, img, input, object, source and video elements.
+func Width(v string) Node {
+	return Attribute("width", v)
+}
+
+// WrapValue is a value of the wrap attribute.
+type WrapValue string
+
+// String returns the string representation of the WrapValue.
+func (v WrapValue) String() string {
+	return string(v)
+}
+
+// List of values of the wrap attribute.
+const (
+	WrapSoft WrapValue = "soft"
+	WrapHard WrapValue = "hard"
+	WrapOff  WrapValue = "off"
+)
+
+// Wrap sets the wrap attribute for textarea elements.
+// It applies to the textarea elements.
+func Wrap(v WrapValue) Node {
+	return Attribute("wrap", v.String())
+}
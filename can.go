@@ -0,0 +1,148 @@
+package htmx
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/gofiber/fiber/v2"
+	authz "github.com/zeiss/fiber-authz"
+)
+
+// authzState is the checker and the principal of the request.
+type authzState struct {
+	checker   authz.AuthzChecker
+	principal authz.AuthzPrincipal
+}
+
+// WithAuthz sets the checker and the principal that are used by Can to render the nodes of the request.
+func WithAuthz(c *fiber.Ctx, checker authz.AuthzChecker, principal authz.AuthzPrincipal) {
+	c.Locals(authzKey, authzState{checker: checker, principal: principal})
+}
+
+// NewAuthzHandler returns a new middleware that resolves the principal of the request
+// and sets it with the AuthzChecker of the config for Can.
+func NewAuthzHandler(resolver authz.AuthzPrincipalResolver, config ...Config) fiber.Handler {
+	cfg := configDefault(config...)
+
+	return func(c *fiber.Ctx) error {
+		if cfg.Next != nil && cfg.Next(c) {
+			return c.Next()
+		}
+
+		principal, err := resolver.Resolve(c)
+		if err != nil {
+			return cfg.ErrorHandler(c, err)
+		}
+
+		WithAuthz(c, cfg.AuthzChecker, principal)
+
+		return c.Next()
+	}
+}
+
+// Allowed returns true if the principal of the request is allowed to do the action on the object.
+// The action is not allowed if no checker is set for the request.
+func Allowed(c *fiber.Ctx, object authz.AuthzObject, action authz.AuthzAction) (bool, error) {
+	if c == nil {
+		return false, nil
+	}
+
+	state, ok := c.Locals(authzKey).(authzState)
+	if !ok || state.checker == nil {
+		return false, nil
+	}
+
+	return state.checker.Allowed(c.UserContext(), state.principal, object, action)
+}
+
+// Permission is an action on an object that the principal must be allowed to do.
+type Permission struct {
+	// Object is the object of the permission.
+	Object authz.AuthzObject
+	// Action is the action of the permission.
+	Action authz.AuthzAction
+}
+
+// Permitted renders the node if the permission is nil or the principal of the request has the permission.
+func Permitted(p *Permission, n Node) Node {
+	if p == nil {
+		return n
+	}
+
+	return Can(p.Object, p.Action, n)
+}
+
+type can struct {
+	object authz.AuthzObject
+	action authz.AuthzAction
+	n      Node
+	elseN  Node
+}
+
+// Can renders the node if the principal of the request is allowed to do the action on the object.
+// The request is taken from the writer, so the node must be rendered into the fiber.Ctx e.g. by RenderComp.
+func Can(object authz.AuthzObject, action authz.AuthzAction, n Node) Node {
+	return can{object: object, action: action, n: n}
+}
+
+// CanElse renders the node if the principal of the request is allowed to do the action on the object,
+// otherwise it renders the else node.
+func CanElse(object authz.AuthzObject, action authz.AuthzAction, n, elseN Node) Node {
+	return can{object: object, action: action, n: n, elseN: elseN}
+}
+
+// Render renders the node if the action is allowed.
+func (c can) Render(w io.Writer) error {
+	allowed, err := Allowed(ctxFromWriter(w), c.object, c.action)
+	if err != nil {
+		return err
+	}
+
+	n := c.elseN
+	if allowed {
+		n = c.n
+	}
+
+	if n == nil {
+		return nil
+	}
+
+	return n.Render(w)
+}
+
+// Type returns the node type of the allowed node.
+func (c can) Type() NodeType {
+	if p, ok := c.n.(NodeTypeDescriptor); ok {
+		return p.Type()
+	}
+
+	return ElementType
+}
+
+// renderBuffer is a buffer that keeps the request of the writer it is rendered for.
+type renderBuffer struct {
+	bytes.Buffer
+	ctx *fiber.Ctx
+}
+
+// newRenderBuffer returns a new buffer for the writer.
+func newRenderBuffer(w io.Writer) *renderBuffer {
+	return &renderBuffer{ctx: ctxFromWriter(w)}
+}
+
+// Ctx returns the request of the buffer.
+func (b *renderBuffer) Ctx() *fiber.Ctx {
+	return b.ctx
+}
+
+// ctxFromWriter returns the request that is rendered into the writer, nil if there is none.
+func ctxFromWriter(w io.Writer) *fiber.Ctx {
+	switch v := w.(type) {
+	case *fiber.Ctx:
+		return v
+	case interface{ Ctx() *fiber.Ctx }:
+		return v.Ctx()
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,97 @@
+package htmx_test
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authz "github.com/zeiss/fiber-authz"
+	htmx "github.com/zeiss/fiber-htmx"
+	nodeassert "github.com/zeiss/fiber-htmx/internal/assert"
+)
+
+type roleChecker map[authz.AuthzPrincipal][]authz.AuthzAction
+
+func (r roleChecker) Allowed(_ context.Context, principal authz.AuthzPrincipal, _ authz.AuthzObject, action authz.AuthzAction) (bool, error) {
+	for _, a := range r[principal] {
+		if a == action {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+type headerPrincipal struct{}
+
+func (headerPrincipal) Resolve(c *fiber.Ctx) (authz.AuthzPrincipal, error) {
+	return authz.AuthzPrincipal(c.Get("X-User")), nil
+}
+
+func TestCan(t *testing.T) {
+	t.Parallel()
+
+	checker := roleChecker{
+		"admin":  {"read", "delete"},
+		"viewer": {"read"},
+	}
+
+	page := htmx.Div(
+		htmx.Can("project", "read", htmx.Span(htmx.Text("read"))),
+		htmx.Fallback(
+			htmx.CanElse("project", "delete", htmx.Button(htmx.Text("delete")), htmx.Text("no delete")),
+			func(err error) htmx.Node { return htmx.Text(err.Error()) },
+		),
+		htmx.Permitted(nil, htmx.Text("public")),
+	)
+
+	tests := []struct {
+		name string
+		user string
+		want string
+	}{
+		{
+			name: "admin",
+			user: "admin",
+			want: `<div><span>read</span><button>delete</button>public</div>`,
+		},
+		{
+			name: "viewer",
+			user: "viewer",
+			want: `<div><span>read</span>no deletepublic</div>`,
+		},
+		{
+			name: "anonymous",
+			want: `<div>no deletepublic</div>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Use(htmx.NewAuthzHandler(headerPrincipal{}, htmx.Config{AuthzChecker: checker}))
+			app.Get("/", htmx.NewCompHandler(page))
+
+			req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+			req.Header.Set("X-User", test.user)
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, string(body))
+		})
+	}
+}
+
+func TestCanWithoutRequest(t *testing.T) {
+	t.Parallel()
+
+	nodeassert.Equal(t, "<div></div>", htmx.Div(htmx.Can("project", "read", htmx.Text("read"))))
+	nodeassert.Equal(t, "<div>denied</div>", htmx.Div(htmx.CanElse("project", "read", htmx.Text("read"), htmx.Text("denied"))))
+}
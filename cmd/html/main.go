@@ -0,0 +1,157 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/ettle/strcase"
+	"github.com/katallaxie/pkg/logx"
+	"github.com/spf13/pflag"
+)
+
+// Spec is the specification of the HTML elements and attributes.
+type Spec struct {
+	// Elements is the list of HTML elements.
+	Elements []ElementSpec `json:"elements"`
+	// Attributes is the list of global and element-specific attributes.
+	Attributes []AttributeSpec `json:"attributes"`
+	// Aria is the specification of the WAI-ARIA roles, states and properties.
+	Aria AriaSpec `json:"aria"`
+}
+
+// ElementSpec is the specification of an HTML element.
+type ElementSpec struct {
+	// Tag is the name of the element.
+	Tag string `json:"tag"`
+	// Func is the name of the generated function.
+	Func string `json:"func"`
+	// Description is the human readable name of the element.
+	Description string `json:"description,omitempty"`
+	// Aliases are additional function names for the element.
+	Aliases []string `json:"aliases,omitempty"`
+	// Deprecated are previous function names for the element that are kept for compatibility.
+	Deprecated []string `json:"deprecated,omitempty"`
+}
+
+// AttributeSpec is the specification of an HTML attribute.
+type AttributeSpec struct {
+	// Name is the name of the attribute.
+	Name string `json:"name"`
+	// Func is the name of the generated function.
+	Func string `json:"func"`
+	// Type is the type of the attribute value.
+	// Valid types are string, bool, booleanish, enum, int, number, idref, idrefs and tokens.
+	Type string `json:"type"`
+	// Description describes the elements the attribute applies to.
+	Description string `json:"description,omitempty"`
+	// Global is true if the attribute applies to all elements.
+	Global bool `json:"global,omitempty"`
+	// Elements is the list of elements the attribute applies to, it is listed in the documentation.
+	Elements []string `json:"elements,omitempty"`
+	// Values is the list of allowed values for enum and tokens attributes.
+	Values []string `json:"values,omitempty"`
+}
+
+// RoleSpec is the specification of a WAI-ARIA role.
+type RoleSpec struct {
+	// Name is the name of the role.
+	Name string `json:"name"`
+	// Func is the name of the generated function without the Role prefix.
+	Func string `json:"func"`
+}
+
+// AriaSpec is the specification of the WAI-ARIA roles, states and properties.
+type AriaSpec struct {
+	// Roles is the list of WAI-ARIA roles.
+	Roles []RoleSpec `json:"roles"`
+	// Attributes is the list of WAI-ARIA states and properties.
+	Attributes []AttributeSpec `json:"attributes"`
+}
+
+type flags struct {
+	Spec      string
+	Templates string
+	Output    string
+}
+
+var funcs = template.FuncMap{
+	"pascal": strcase.ToPascal,
+	"list":   list,
+}
+
+// list joins the items into a list e.g. a, area and link.
+func list(items []string) string {
+	if len(items) < 2 {
+		return strings.Join(items, "")
+	}
+
+	return strings.Join(items[:len(items)-1], ", ") + " and " + items[len(items)-1]
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetOutput(os.Stderr)
+
+	logx.RedirectStdLog(logx.LogSink)
+
+	f := &flags{
+		Spec:      "cmd/html/spec.json",
+		Templates: "cmd/html",
+		Output:    ".",
+	}
+
+	pflag.StringVar(&f.Spec, "spec", f.Spec, "spec")
+	pflag.StringVar(&f.Templates, "templates", f.Templates, "templates")
+	pflag.StringVar(&f.Output, "output", f.Output, "output")
+	pflag.Parse()
+
+	b, err := os.ReadFile(f.Spec)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var spec Spec
+	err = json.Unmarshal(b, &spec)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	files := map[string]string{
+		"elements.tmpl":   "elements_gen.go",
+		"attributes.tmpl": "attributes_gen.go",
+		"aria.tmpl":       "aria_gen.go",
+	}
+
+	for src, dst := range files {
+		err := generate(filepath.Join(f.Templates, src), filepath.Join(f.Output, dst), spec)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+func generate(src, dst string, spec Spec) error {
+	tmpl, err := template.New(filepath.Base(src)).Funcs(funcs).ParseFiles(src)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, spec)
+	if err != nil {
+		return err
+	}
+
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(dst, out, 0o644)
+}
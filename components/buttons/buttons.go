@@ -5,13 +5,14 @@ import htmx "github.com/zeiss/fiber-htmx"
 // ButtonProps represents the properties for a button element.
 type ButtonProps struct {
 	ClassNames htmx.ClassNames
-	Type       string // The type of the button element.
-	Disabled   bool   // Whether the button element is disabled.
+	Type       string           // The type of the button element.
+	Disabled   bool             // Whether the button element is disabled.
+	Permission *htmx.Permission // The button is only rendered if the principal has the permission.
 }
 
 // Button generates a button element based on the provided properties.
 func Button(props ButtonProps, children ...htmx.Node) htmx.Node {
-	return htmx.Button(
+	return htmx.Permitted(props.Permission, htmx.Button(
 		htmx.Merge(
 			htmx.ClassNames{
 				"btn": true,
@@ -21,12 +22,12 @@ func Button(props ButtonProps, children ...htmx.Node) htmx.Node {
 		htmx.Attribute("type", props.Type),
 		htmx.If(props.Disabled, htmx.Disabled()),
 		htmx.Group(children...),
-	)
+	))
 }
 
 // Primary generates a primary button element based on the provided properties.
 func Primary(props ButtonProps, children ...htmx.Node) htmx.Node {
-	return htmx.Button(
+	return htmx.Permitted(props.Permission, htmx.Button(
 		htmx.Merge(
 			htmx.ClassNames{
 				"btn":         true,
@@ -37,12 +38,12 @@ func Primary(props ButtonProps, children ...htmx.Node) htmx.Node {
 		htmx.Attribute("type", props.Type),
 		htmx.If(props.Disabled, htmx.Disabled()),
 		htmx.Group(children...),
-	)
+	))
 }
 
 // Neutral generates a neutral button element based on the provided properties.
 func Neutral(props ButtonProps, children ...htmx.Node) htmx.Node {
-	return htmx.Button(
+	return htmx.Permitted(props.Permission, htmx.Button(
 		htmx.Merge(
 			htmx.ClassNames{
 				"btn":         true,
@@ -53,12 +54,12 @@ func Neutral(props ButtonProps, children ...htmx.Node) htmx.Node {
 		htmx.Attribute("type", props.Type),
 		htmx.If(props.Disabled, htmx.Disabled()),
 		htmx.Group(children...),
-	)
+	))
 }
 
 // Secondary generates a secondary button element based on the provided properties.
 func Secondary(props ButtonProps, children ...htmx.Node) htmx.Node {
-	return htmx.Button(
+	return htmx.Permitted(props.Permission, htmx.Button(
 		htmx.Merge(
 			htmx.ClassNames{
 				"btn":           true,
@@ -69,12 +70,12 @@ func Secondary(props ButtonProps, children ...htmx.Node) htmx.Node {
 		htmx.Attribute("type", props.Type),
 		htmx.If(props.Disabled, htmx.Disabled()),
 		htmx.Group(children...),
-	)
+	))
 }
 
 // Accent generates an accent button element based on the provided properties.
 func Accent(props ButtonProps, children ...htmx.Node) htmx.Node {
-	return htmx.Button(
+	return htmx.Permitted(props.Permission, htmx.Button(
 		htmx.Merge(
 			htmx.ClassNames{
 				"btn":        true,
@@ -85,12 +86,12 @@ func Accent(props ButtonProps, children ...htmx.Node) htmx.Node {
 		htmx.Attribute("type", props.Type),
 		htmx.If(props.Disabled, htmx.Disabled()),
 		htmx.Group(children...),
-	)
+	))
 }
 
 // Ghost generates a ghost button element based on the provided properties.
 func Ghost(props ButtonProps, children ...htmx.Node) htmx.Node {
-	return htmx.Button(
+	return htmx.Permitted(props.Permission, htmx.Button(
 		htmx.Merge(
 			htmx.ClassNames{
 				"btn":       true,
@@ -101,12 +102,12 @@ func Ghost(props ButtonProps, children ...htmx.Node) htmx.Node {
 		htmx.Attribute("type", props.Type),
 		htmx.If(props.Disabled, htmx.Disabled()),
 		htmx.Group(children...),
-	)
+	))
 }
 
 // Link generates a link button element based on the provided properties.
 func Link(props ButtonProps, children ...htmx.Node) htmx.Node {
-	return htmx.Button(
+	return htmx.Permitted(props.Permission, htmx.Button(
 		htmx.Merge(
 			htmx.ClassNames{
 				"btn":      true,
@@ -117,12 +118,12 @@ func Link(props ButtonProps, children ...htmx.Node) htmx.Node {
 		htmx.Attribute("type", props.Type),
 		htmx.If(props.Disabled, htmx.Disabled()),
 		htmx.Group(children...),
-	)
+	))
 }
 
 // Info generates an info button element based on the provided properties.
 func Info(props ButtonProps, children ...htmx.Node) htmx.Node {
-	return htmx.Button(
+	return htmx.Permitted(props.Permission, htmx.Button(
 		htmx.Merge(
 			htmx.ClassNames{
 				"btn":      true,
@@ -133,12 +134,12 @@ func Info(props ButtonProps, children ...htmx.Node) htmx.Node {
 		htmx.Attribute("type", props.Type),
 		htmx.If(props.Disabled, htmx.Disabled()),
 		htmx.Group(children...),
-	)
+	))
 }
 
 // Success generates a success button element based on the provided properties.
 func Success(props ButtonProps, children ...htmx.Node) htmx.Node {
-	return htmx.Button(
+	return htmx.Permitted(props.Permission, htmx.Button(
 		htmx.Merge(
 			htmx.ClassNames{
 				"btn":         true,
@@ -149,12 +150,12 @@ func Success(props ButtonProps, children ...htmx.Node) htmx.Node {
 		htmx.Attribute("type", props.Type),
 		htmx.If(props.Disabled, htmx.Disabled()),
 		htmx.Group(children...),
-	)
+	))
 }
 
 // Warning generates a warning button element based on the provided properties.
 func Warning(props ButtonProps, children ...htmx.Node) htmx.Node {
-	return htmx.Button(
+	return htmx.Permitted(props.Permission, htmx.Button(
 		htmx.Merge(
 			htmx.ClassNames{
 				"btn":         true,
@@ -165,12 +166,12 @@ func Warning(props ButtonProps, children ...htmx.Node) htmx.Node {
 		htmx.Attribute("type", props.Type),
 		htmx.If(props.Disabled, htmx.Disabled()),
 		htmx.Group(children...),
-	)
+	))
 }
 
 // Error generates an error button element based on the provided properties.
 func Error(props ButtonProps, children ...htmx.Node) htmx.Node {
-	return htmx.Button(
+	return htmx.Permitted(props.Permission, htmx.Button(
 		htmx.Merge(
 			htmx.ClassNames{
 				"btn":       true,
@@ -181,12 +182,12 @@ func Error(props ButtonProps, children ...htmx.Node) htmx.Node {
 		htmx.Attribute("type", props.Type),
 		htmx.If(props.Disabled, htmx.Disabled()),
 		htmx.Group(children...),
-	)
+	))
 }
 
 // Outline generates an outline button element based on the provided properties.
 func Outline(props ButtonProps, children ...htmx.Node) htmx.Node {
-	return htmx.Button(
+	return htmx.Permitted(props.Permission, htmx.Button(
 		htmx.Merge(
 			htmx.ClassNames{
 				"btn":         true,
@@ -197,12 +198,12 @@ func Outline(props ButtonProps, children ...htmx.Node) htmx.Node {
 		htmx.Attribute("type", props.Type),
 		htmx.If(props.Disabled, htmx.Disabled()),
 		htmx.Group(children...),
-	)
+	))
 }
 
 // OutlinePrimary generates an outline primary button element based on the provided properties.
 func OutlinePrimary(props ButtonProps, children ...htmx.Node) htmx.Node {
-	return htmx.Button(
+	return htmx.Permitted(props.Permission, htmx.Button(
 		htmx.Merge(
 			htmx.ClassNames{
 				"btn":         true,
@@ -214,12 +215,12 @@ func OutlinePrimary(props ButtonProps, children ...htmx.Node) htmx.Node {
 		htmx.Attribute("type", props.Type),
 		htmx.If(props.Disabled, htmx.Disabled()),
 		htmx.Group(children...),
-	)
+	))
 }
 
 // OutlineSecondary generates an outline secondary button element based on the provided properties.
 func OutlineSecondary(props ButtonProps, children ...htmx.Node) htmx.Node {
-	return htmx.Button(
+	return htmx.Permitted(props.Permission, htmx.Button(
 		htmx.Merge(
 			htmx.ClassNames{
 				"btn":           true,
@@ -231,12 +232,12 @@ func OutlineSecondary(props ButtonProps, children ...htmx.Node) htmx.Node {
 		htmx.Attribute("type", props.Type),
 		htmx.If(props.Disabled, htmx.Disabled()),
 		htmx.Group(children...),
-	)
+	))
 }
 
 // OutlineAccent generates an outline accent button element based on the provided properties.
 func OutlineAccent(props ButtonProps, children ...htmx.Node) htmx.Node {
-	return htmx.Button(
+	return htmx.Permitted(props.Permission, htmx.Button(
 		htmx.Merge(
 			htmx.ClassNames{
 				"btn":         true,
@@ -248,12 +249,12 @@ func OutlineAccent(props ButtonProps, children ...htmx.Node) htmx.Node {
 		htmx.Attribute("type", props.Type),
 		htmx.If(props.Disabled, htmx.Disabled()),
 		htmx.Group(children...),
-	)
+	))
 }
 
 // OutlineInfo generates an outline info button element based on the provided properties.
 func OutlineInfo(props ButtonProps, children ...htmx.Node) htmx.Node {
-	return htmx.Button(
+	return htmx.Permitted(props.Permission, htmx.Button(
 		htmx.Merge(
 			htmx.ClassNames{
 				"btn":         true,
@@ -265,12 +266,12 @@ func OutlineInfo(props ButtonProps, children ...htmx.Node) htmx.Node {
 		htmx.Attribute("type", props.Type),
 		htmx.If(props.Disabled, htmx.Disabled()),
 		htmx.Group(children...),
-	)
+	))
 }
 
 // OutlineSuccess generates an outline success button element based on the provided properties.
 func OutlineSuccess(props ButtonProps, children ...htmx.Node) htmx.Node {
-	return htmx.Button(
+	return htmx.Permitted(props.Permission, htmx.Button(
 		htmx.Merge(
 			htmx.ClassNames{
 				"btn":         true,
@@ -282,12 +283,12 @@ func OutlineSuccess(props ButtonProps, children ...htmx.Node) htmx.Node {
 		htmx.Attribute("type", props.Type),
 		htmx.If(props.Disabled, htmx.Disabled()),
 		htmx.Group(children...),
-	)
+	))
 }
 
 // OutlineWarning generates an outline warning button element based on the provided properties.
 func OutlineWarning(props ButtonProps, children ...htmx.Node) htmx.Node {
-	return htmx.Button(
+	return htmx.Permitted(props.Permission, htmx.Button(
 		htmx.Merge(
 			htmx.ClassNames{
 				"btn":         true,
@@ -299,12 +300,12 @@ func OutlineWarning(props ButtonProps, children ...htmx.Node) htmx.Node {
 		htmx.Attribute("type", props.Type),
 		htmx.If(props.Disabled, htmx.Disabled()),
 		htmx.Group(children...),
-	)
+	))
 }
 
 // OutlineError generates an outline error button element based on the provided properties.
 func OutlineError(props ButtonProps, children ...htmx.Node) htmx.Node {
-	return htmx.Button(
+	return htmx.Permitted(props.Permission, htmx.Button(
 		htmx.Merge(
 			htmx.ClassNames{
 				"btn":         true,
@@ -316,12 +317,12 @@ func OutlineError(props ButtonProps, children ...htmx.Node) htmx.Node {
 		htmx.Attribute("type", props.Type),
 		htmx.If(props.Disabled, htmx.Disabled()),
 		htmx.Group(children...),
-	)
+	))
 }
 
 // Glass generates a glass button element based on the provided properties.
 func Glass(props ButtonProps, children ...htmx.Node) htmx.Node {
-	return htmx.Button(
+	return htmx.Permitted(props.Permission, htmx.Button(
 		htmx.Merge(
 			htmx.ClassNames{
 				"btn":         true,
@@ -333,12 +334,12 @@ func Glass(props ButtonProps, children ...htmx.Node) htmx.Node {
 		htmx.Attribute("type", props.Type),
 		htmx.If(props.Disabled, htmx.Disabled()),
 		htmx.Group(children...),
-	)
+	))
 }
 
 // Circle generates a circle button element based on the provided properties.
 func Circle(props ButtonProps, children ...htmx.Node) htmx.Node {
-	return htmx.Button(
+	return htmx.Permitted(props.Permission, htmx.Button(
 		htmx.Merge(
 			htmx.ClassNames{
 				"btn":        true,
@@ -349,7 +350,7 @@ func Circle(props ButtonProps, children ...htmx.Node) htmx.Node {
 		htmx.Attribute("type", props.Type),
 		htmx.If(props.Disabled, htmx.Disabled()),
 		htmx.Group(children...),
-	)
+	))
 }
 
 // CircleSmall generates a small circle button element based on the provided properties.
@@ -363,6 +364,7 @@ func CircleSmall(props ButtonProps, children ...htmx.Node) htmx.Node {
 				},
 				props.ClassNames,
 			),
+			Permission: props.Permission,
 		},
 		children...,
 	)
@@ -378,6 +380,7 @@ func CircleMedium(props ButtonProps, children ...htmx.Node) htmx.Node {
 				},
 				props.ClassNames,
 			),
+			Permission: props.Permission,
 		},
 		children...,
 	)
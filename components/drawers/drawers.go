@@ -78,16 +78,20 @@ func DrawerSideMenu(p DrawerSideMenuProps, children ...htmx.Node) htmx.Node {
 type DrawerSideMenuItemProps struct {
 	ID         string
 	ClassNames htmx.ClassNames
+	Permission *htmx.Permission // The item is only rendered if the principal has the permission.
 }
 
 // DrawerSideMenuItem is a component that renders a drawer side menu item
 func DrawerSideMenuItem(p DrawerSideMenuItemProps, children ...htmx.Node) htmx.Node {
-	return htmx.Li(
-		htmx.Merge(
-			htmx.ClassNames{},
-			p.ClassNames,
+	return htmx.Permitted(
+		p.Permission,
+		htmx.Li(
+			htmx.Merge(
+				htmx.ClassNames{},
+				p.ClassNames,
+			),
+			htmx.Group(children...),
 		),
-		htmx.Group(children...),
 	)
 }
 
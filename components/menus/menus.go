@@ -26,18 +26,22 @@ func Menu(p MenuProps, children ...htmx.Node) htmx.Node {
 // MenuItemProps is the struct for the menu item props
 type MenuItemProps struct {
 	ClassNames htmx.ClassNames
+	Permission *htmx.Permission // The item is only rendered if the principal has the permission.
 }
 
 // MenuItem is the component for the menu item
 func MenuItem(p MenuItemProps, children ...htmx.Node) htmx.Node {
-	return htmx.Li(
-		htmx.Merge(
-			htmx.ClassNames{
-				"menu-item": true,
-			},
-			p.ClassNames,
+	return htmx.Permitted(
+		p.Permission,
+		htmx.Li(
+			htmx.Merge(
+				htmx.ClassNames{
+					"menu-item": true,
+				},
+				p.ClassNames,
+			),
+			htmx.Group(children...),
 		),
-		htmx.Group(children...),
 	)
 }
 
@@ -2,11 +2,15 @@ package toasts
 
 import (
 	"encoding/json"
+	"slices"
 
 	"github.com/gofiber/fiber/v2"
 	htmx "github.com/zeiss/fiber-htmx"
 )
 
+// NotifyEvent is the event that displays a toast.
+const NotifyEvent = "htmx-toasts:notify"
+
 const (
 	// INFO is the info level.
 	INFO = "info"
@@ -76,7 +80,7 @@ func (t Toast) ToJson() (string, error) {
 	t.Message = t.Error()
 
 	eventMap := map[string]Toast{}
-	eventMap["htmx-toasts:notify"] = t
+	eventMap[NotifyEvent] = t
 
 	jsonData, err := json.Marshal(eventMap)
 	if err != nil {
@@ -87,15 +91,9 @@ func (t Toast) ToJson() (string, error) {
 }
 
 // SetHXTriggerHeader sets the HTMX trigger header.
+// The toast is merged with the other events of the request.
 func (t Toast) SetHXTriggerHeader(c *fiber.Ctx) error {
-	jsonData, err := t.ToJson()
-	if err != nil {
-		return err
-	}
-
-	htmx.Trigger(c, jsonData)
-
-	return nil
+	return htmx.TriggerEvent(c, NotifyEvent, t)
 }
 
 // ToasterProps is the properties for the Toaster component.
@@ -104,7 +102,67 @@ type ToasterProps struct {
 	ClassNames htmx.ClassNames
 }
 
+// Notifications returns the toasts and the messages of the request,
+// including the flash of the previous request.
+func Notifications(c *fiber.Ctx) []Toast {
+	triggers := htmx.TriggersFromContext(c)
+	details := triggers.Details(htmx.HXTrigger, NotifyEvent)
+
+	toasts := []Toast{}
+	for _, d := range details {
+		var t Toast
+		if err := convert(d, &t); err == nil {
+			toasts = append(toasts, t)
+		}
+	}
+
+	messages := triggers.Details(htmx.HXTrigger, "messages")
+	if msgs := htmx.MessagesFromContext(c); msgs != nil && !slices.Contains(messages, any(msgs)) {
+		messages = append(messages, msgs)
+	}
+
+	for _, d := range messages {
+		var msgs htmx.HtmxMessages
+		if err := convert(d, &msgs); err != nil {
+			continue
+		}
+
+		for _, m := range msgs {
+			toasts = append(toasts, New(m.Tags, m.Message))
+		}
+	}
+
+	return toasts
+}
+
+// convert converts a detail of an event into the given value.
+func convert(detail any, v any) error {
+	b, err := json.Marshal(detail)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, v)
+}
+
 // Toast is the toast component.
+// On full page loads the notifications of the request are rendered with the component.
 func Toasts() htmx.Node {
-	return htmx.CustomElement("htmx-toasts")
+	return htmx.FromCtx(func(c *fiber.Ctx) htmx.Node {
+		if c == nil || htmx.Request(c) {
+			return htmx.CustomElement("htmx-toasts")
+		}
+
+		notifications := Notifications(c)
+		if len(notifications) == 0 {
+			return htmx.CustomElement("htmx-toasts")
+		}
+
+		b, err := json.Marshal(notifications)
+		if err != nil {
+			return htmx.CustomElement("htmx-toasts")
+		}
+
+		return htmx.CustomElement("htmx-toasts", htmx.Attribute("notifications", string(b)))
+	})
 }
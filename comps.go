@@ -1,7 +1,6 @@
 package htmx
 
 import (
-	"bytes"
 	"fmt"
 	"html/template"
 	"io"
@@ -309,16 +308,32 @@ type errorBoundary struct {
 // ErrBoundaryFunc is a function that returns a node.
 type ErrBoundaryFunc func() Node
 
-// ErrorBoundary is a node that catches panics and renders an error.
+// ErrorBoundary is a node that catches panics in its subtree and returns them as an error.
+// Nothing is written if the subtree fails, wrap it into a Fallback to render an error instead.
 func ErrorBoundary(n ErrBoundaryFunc) Node {
 	return errorBoundary{n: n}
 }
 
 // Render is a node that renders an error boundary.
-func (c errorBoundary) Render(w io.Writer) error {
+func (c errorBoundary) Render(w io.Writer) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoverError(r)
+		}
+	}()
+
+	b := newRenderBuffer(w)
+
 	n := c.n()
+	if n != nil {
+		if err := n.Render(b); err != nil {
+			return err
+		}
+	}
 
-	return n.Render(w)
+	_, err = io.Copy(w, b)
+
+	return err
 }
 
 type fallback struct {
@@ -348,13 +363,13 @@ func (c fallback) Render(w io.Writer) (err error) {
 		}
 	}()
 
-	var b bytes.Buffer
+	b := newRenderBuffer(w)
 
-	if err := c.n.Render(&b); err != nil {
+	if err := c.n.Render(b); err != nil {
 		return c.f(err).Render(w)
 	}
 
-	_, err = io.Copy(w, &b)
+	_, err = io.Copy(w, b)
 
 	return err
 }
@@ -0,0 +1,115 @@
+package htmx
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/zeiss/pkg/cast"
+)
+
+// HtmxResponseHandling is a rule of the htmx response handling configuration.
+// See: https://htmx.org/docs/#response-handling
+type HtmxResponseHandling struct {
+	// Code is a regular expression that is matched against the status code of the response e.g. 204 or [45]...
+	Code string `json:"code"`
+	// Swap is true if the response should be swapped into the DOM.
+	Swap bool `json:"swap"`
+	// Error is true if the response should be treated as an error.
+	Error *bool `json:"error,omitempty"`
+	// IgnoreTitle is true if the title of the response should be ignored.
+	IgnoreTitle *bool `json:"ignoreTitle,omitempty"`
+	// Select is a CSS selector to select the content from the response.
+	Select string `json:"select,omitempty"`
+	// Target is a CSS selector of an alternative target for the response.
+	Target string `json:"target,omitempty"`
+	// SwapOverride is an alternative swap style for the response.
+	SwapOverride HXSwapStyle `json:"swapOverride,omitempty"`
+}
+
+// HtmxConfig is the runtime configuration of htmx.
+// It renders as the htmx-config meta element in the head of the page.
+// See: https://htmx.org/reference/#config
+type HtmxConfig struct {
+	// DefaultSwapStyle is the default swap style.
+	DefaultSwapStyle HXSwapStyle
+	// DefaultSwapDelay is the default delay between receiving a response and swapping the content.
+	DefaultSwapDelay *time.Duration
+	// DefaultSettleDelay is the default delay between swapping and settling the content.
+	DefaultSettleDelay *time.Duration
+	// HistoryCacheSize is the number of pages in the history cache.
+	HistoryCacheSize *int
+	// SelfRequestsOnly is true if only requests to the same domain are allowed.
+	SelfRequestsOnly *bool
+	// AllowScriptTags is true if script tags in new content are processed.
+	AllowScriptTags *bool
+	// GlobalViewTransitions is true if the view transitions API is used when swapping content.
+	GlobalViewTransitions *bool
+	// IncludeIndicatorStyles is true if the default indicator styles are loaded.
+	IncludeIndicatorStyles *bool
+	// ResponseHandling are the rules how responses are handled per status code.
+	ResponseHandling []HtmxResponseHandling
+}
+
+// NewHtmxConfig returns a new htmx configuration with the default swap and settle delays.
+func NewHtmxConfig() *HtmxConfig {
+	return &HtmxConfig{
+		DefaultSwapDelay:   cast.Ptr(HxDefaultSwapDuration),
+		DefaultSettleDelay: cast.Ptr(HxDefaultSettleDelay),
+	}
+}
+
+type htmxConfig struct {
+	DefaultSwapStyle       HXSwapStyle            `json:"defaultSwapStyle,omitempty"`
+	DefaultSwapDelay       *int64                 `json:"defaultSwapDelay,omitempty"`
+	DefaultSettleDelay     *int64                 `json:"defaultSettleDelay,omitempty"`
+	HistoryCacheSize       *int                   `json:"historyCacheSize,omitempty"`
+	SelfRequestsOnly       *bool                  `json:"selfRequestsOnly,omitempty"`
+	AllowScriptTags        *bool                  `json:"allowScriptTags,omitempty"`
+	GlobalViewTransitions  *bool                  `json:"globalViewTransitions,omitempty"`
+	IncludeIndicatorStyles *bool                  `json:"includeIndicatorStyles,omitempty"`
+	ResponseHandling       []HtmxResponseHandling `json:"responseHandling,omitempty"`
+}
+
+func milliseconds(d *time.Duration) *int64 {
+	if d == nil {
+		return nil
+	}
+
+	return cast.Ptr(d.Milliseconds())
+}
+
+// MarshalJSON returns the JSON encoding of the configuration with the delays in milliseconds.
+func (c HtmxConfig) MarshalJSON() ([]byte, error) {
+	return json.Marshal(htmxConfig{
+		DefaultSwapStyle:       c.DefaultSwapStyle,
+		DefaultSwapDelay:       milliseconds(c.DefaultSwapDelay),
+		DefaultSettleDelay:     milliseconds(c.DefaultSettleDelay),
+		HistoryCacheSize:       c.HistoryCacheSize,
+		SelfRequestsOnly:       c.SelfRequestsOnly,
+		AllowScriptTags:        c.AllowScriptTags,
+		GlobalViewTransitions:  c.GlobalViewTransitions,
+		IncludeIndicatorStyles: c.IncludeIndicatorStyles,
+		ResponseHandling:       c.ResponseHandling,
+	})
+}
+
+// Render renders the configuration as the htmx-config meta element.
+func (c HtmxConfig) Render(w io.Writer) error {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	return Meta(Name("htmx-config"), Content(string(b))).Render(w)
+}
+
+// String returns the configuration as a string.
+func (c HtmxConfig) String() string {
+	var b strings.Builder
+
+	_ = c.Render(&b)
+
+	return b.String()
+}
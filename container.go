@@ -0,0 +1,430 @@
+package htmx
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/gofiber/fiber/v2"
+	goth "github.com/zeiss/fiber-goth"
+	"github.com/zeiss/fiber-goth/adapters"
+	"golang.org/x/text/language"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrServiceNotFound is returned when no service is registered for a type.
+	ErrServiceNotFound = errors.New("htmx: service not found")
+	// ErrServiceExists is returned when a service is registered twice for a type.
+	ErrServiceExists = errors.New("htmx: service already registered")
+	// ErrServiceConstructor is returned when a constructor is not a function that returns a service.
+	ErrServiceConstructor = errors.New("htmx: invalid service constructor")
+	// ErrServiceCycle is returned when services depend on each other.
+	ErrServiceCycle = errors.New("htmx: service dependency cycle")
+	// ErrServiceScope is returned when a singleton depends on a request-scoped service.
+	ErrServiceScope = errors.New("htmx: singleton depends on request-scoped service")
+	// ErrNoContainer is returned when a service is resolved for a request without a container.
+	ErrNoContainer = errors.New("htmx: no container for the request")
+	// ErrInjectField is returned when a tagged field can not be injected.
+	ErrInjectField = errors.New("htmx: field can not be injected")
+)
+
+// InjectTag is the struct tag of fields that are injected by the container.
+// The value optional skips fields that have no registered service.
+const InjectTag = "inject"
+
+// Lifetime is the lifetime of a service.
+type Lifetime int
+
+const (
+	// Singleton services are created once for the container.
+	Singleton Lifetime = iota
+	// RequestScoped services are created once for each request.
+	RequestScoped
+)
+
+// CurrentTx returns the transaction that is in progress for the request when it is called.
+// It is a request-scoped service, the transaction of a TxController begins after
+// the controller is created.
+type CurrentTx func() *gorm.DB
+
+var (
+	ctxType   = reflect.TypeFor[*fiber.Ctx]()
+	errorType = reflect.TypeFor[error]()
+)
+
+type service struct {
+	lifetime Lifetime
+	ctor     reflect.Value
+
+	once  sync.Once
+	value reflect.Value
+	err   error
+}
+
+// Container is a dependency injection container for controllers and services.
+//
+// Services are registered with constructor functions. The parameters of a constructor are
+// resolved from the container and it returns the service and optionally an error.
+// Request-scoped constructors can take the *fiber.Ctx of the request.
+// The container provides the request-scoped services *fiber.Ctx, adapters.GothSession,
+// CurrentTx and language.Tag with the preferred locale of the request.
+type Container struct {
+	mu       sync.RWMutex
+	services map[reflect.Type]*service
+}
+
+// NewContainer returns a new container with the request-scoped services of the request.
+func NewContainer() *Container {
+	ct := &Container{
+		services: make(map[reflect.Type]*service),
+	}
+
+	_ = ct.Scoped(func(c *fiber.Ctx) adapters.GothSession {
+		session, err := goth.SessionFromContext(c)
+		if err != nil {
+			return adapters.GothSession{}
+		}
+
+		return session
+	})
+
+	_ = ct.Scoped(func(c *fiber.Ctx) CurrentTx {
+		return func() *gorm.DB {
+			return TxFromContext(c)
+		}
+	})
+
+	_ = ct.Scoped(func(c *fiber.Ctx) language.Tag {
+		tags, _, err := language.ParseAcceptLanguage(c.Get(fiber.HeaderAcceptLanguage))
+		if err != nil || len(tags) == 0 {
+			return language.Und
+		}
+
+		return tags[0]
+	})
+
+	return ct
+}
+
+// Singleton registers a constructor or a value of a service that is created once.
+func (ct *Container) Singleton(ctor any) error {
+	return ct.register(Singleton, ctor, false)
+}
+
+// Scoped registers a constructor of a service that is created once for each request.
+func (ct *Container) Scoped(ctor any) error {
+	return ct.register(RequestScoped, ctor, false)
+}
+
+// Override replaces the registration of a service with a constructor or a value
+// and keeps its lifetime, e.g. to replace services with fakes in tests.
+// Services that are not registered are registered as singletons.
+func (ct *Container) Override(ctor any) error {
+	return ct.register(Singleton, ctor, true)
+}
+
+func (ct *Container) register(lifetime Lifetime, ctor any, override bool) error {
+	fn := reflect.ValueOf(ctor)
+	if !fn.IsValid() {
+		return ErrServiceConstructor
+	}
+
+	if fn.Kind() != reflect.Func {
+		value := fn
+		fn = reflect.MakeFunc(reflect.FuncOf(nil, []reflect.Type{value.Type()}, false), func([]reflect.Value) []reflect.Value {
+			return []reflect.Value{value}
+		})
+	}
+
+	typ, err := serviceType(fn.Type())
+	if err != nil {
+		return err
+	}
+
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+
+	existing, ok := ct.services[typ]
+
+	switch {
+	case ok && !override:
+		return fmt.Errorf("%w: %s", ErrServiceExists, typ)
+	case ok:
+		lifetime = existing.lifetime
+	}
+
+	ct.services[typ] = &service{lifetime: lifetime, ctor: fn}
+
+	return nil
+}
+
+// serviceType returns the type of the service of a constructor.
+func serviceType(fn reflect.Type) (reflect.Type, error) {
+	switch {
+	case fn.NumOut() == 1:
+	case fn.NumOut() == 2 && fn.Out(1) == errorType:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrServiceConstructor, fn)
+	}
+
+	return fn.Out(0), nil
+}
+
+// lookup returns the service of the type.
+func (ct *Container) lookup(typ reflect.Type) (*service, bool) {
+	ct.mu.RLock()
+	defer ct.mu.RUnlock()
+
+	s, ok := ct.services[typ]
+
+	return s, ok
+}
+
+// Scope returns the request scope of the container for the request.
+func (ct *Container) Scope(c *fiber.Ctx) *RequestScope {
+	if s, ok := c.Locals(containerKey).(*RequestScope); ok && s.ct == ct {
+		return s
+	}
+
+	s := &RequestScope{ct: ct, c: c, values: make(map[reflect.Type]reflect.Value)}
+	c.Locals(containerKey, s)
+
+	return s
+}
+
+// NewContainerHandler returns a new middleware that adds the request scope of the container to the request.
+func NewContainerHandler(ct *Container) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ct.Scope(c)
+
+		return c.Next()
+	}
+}
+
+// RequestScope resolves the services of a container for a single request.
+type RequestScope struct {
+	ct     *Container
+	c      *fiber.Ctx
+	values map[reflect.Type]reflect.Value
+}
+
+// Resolve returns the service of the type T for the request.
+func Resolve[T any](c *fiber.Ctx) (T, error) {
+	var zero T
+
+	s, ok := c.Locals(containerKey).(*RequestScope)
+	if !ok {
+		return zero, ErrNoContainer
+	}
+
+	v, err := s.resolve(reflect.TypeFor[T](), nil, false)
+	if err != nil {
+		return zero, err
+	}
+
+	t, _ := v.Interface().(T)
+
+	return t, nil
+}
+
+// Inject sets the fields of the struct that are tagged with inject.
+func (s *RequestScope) Inject(target any) error {
+	return s.inject(reflect.ValueOf(target), nil)
+}
+
+func (s *RequestScope) resolve(typ reflect.Type, resolving []reflect.Type, singleton bool) (reflect.Value, error) {
+	if typ == ctxType {
+		if singleton {
+			return reflect.Value{}, fmt.Errorf("%w: %s", ErrServiceScope, typ)
+		}
+
+		return reflect.ValueOf(s.c), nil
+	}
+
+	svc, ok := s.ct.lookup(typ)
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("%w: %s", ErrServiceNotFound, typ)
+	}
+
+	for _, r := range resolving {
+		if r == typ {
+			return reflect.Value{}, fmt.Errorf("%w: %s", ErrServiceCycle, typ)
+		}
+	}
+
+	resolving = append(resolving, typ)
+
+	switch {
+	case svc.lifetime == Singleton:
+		svc.once.Do(func() {
+			svc.value, svc.err = s.call(svc.ctor, resolving, true)
+		})
+
+		return svc.value, svc.err
+	case singleton:
+		return reflect.Value{}, fmt.Errorf("%w: %s", ErrServiceScope, typ)
+	}
+
+	if v, ok := s.values[typ]; ok {
+		return v, nil
+	}
+
+	v, err := s.call(svc.ctor, resolving, false)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	s.values[typ] = v
+
+	return v, nil
+}
+
+// call calls the constructor with its resolved parameters.
+func (s *RequestScope) call(fn reflect.Value, resolving []reflect.Type, singleton bool) (reflect.Value, error) {
+	args := make([]reflect.Value, fn.Type().NumIn())
+
+	for i := range args {
+		v, err := s.resolve(fn.Type().In(i), resolving, singleton)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		args[i] = v
+	}
+
+	out := fn.Call(args)
+	if len(out) == 2 && !out[1].IsNil() {
+		return reflect.Value{}, out[1].Interface().(error)
+	}
+
+	return out[0], nil
+}
+
+// inject sets the tagged fields of a pointer to a struct.
+func (s *RequestScope) inject(v reflect.Value, resolving []reflect.Type) error {
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+
+	v = v.Elem()
+
+	for i := range v.NumField() {
+		field := v.Type().Field(i)
+
+		tag, ok := field.Tag.Lookup(InjectTag)
+		if !ok {
+			continue
+		}
+
+		if !field.IsExported() {
+			return fmt.Errorf("%w: %s.%s is not exported", ErrInjectField, v.Type(), field.Name)
+		}
+
+		value, err := s.resolve(field.Type, resolving, false)
+		if errors.Is(err, ErrServiceNotFound) && tag == "optional" {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("%w: %s.%s: %w", ErrInjectField, v.Type(), field.Name, err)
+		}
+
+		v.Field(i).Set(value)
+	}
+
+	return nil
+}
+
+// controller builds a new controller of the type for the request, with its registered
+// constructor or as a new struct, and injects its tagged fields.
+// Controllers are not cached, whatever lifetime they are registered with.
+func (s *RequestScope) controller(typ reflect.Type) (Controller, error) {
+	var v reflect.Value
+
+	svc, ok := s.ct.lookup(typ)
+
+	switch {
+	case ok:
+		var err error
+
+		v, err = s.call(svc.ctor, []reflect.Type{typ}, false)
+		if err != nil {
+			return nil, err
+		}
+	case typ.Kind() == reflect.Pointer && typ.Elem().Kind() == reflect.Struct:
+		v = reflect.New(typ.Elem())
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrServiceNotFound, typ)
+	}
+
+	err := s.inject(v, []reflect.Type{typ})
+	if err != nil {
+		return nil, err
+	}
+
+	return v.Interface().(Controller), nil
+}
+
+// injectedController is the placeholder of a controller that is built by the container of the config.
+type injectedController interface {
+	Controller
+	controllerType() reflect.Type
+}
+
+type injected[T Controller] struct {
+	DefaultController
+}
+
+func (injected[T]) controllerType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+// Inject returns a factory for controllers of the type T that are built by the container of the config
+// for each request. The controller is created with the constructor that is registered for T,
+// or as a new struct if T is a pointer to a struct, and its fields that are tagged with inject are set.
+//
+//	ct := htmx.NewContainer()
+//	ct.Singleton(db)
+//	ct.Scoped(NewProjectService)
+//
+//	app.Get("/projects", htmx.NewControllerHandler(htmx.Inject[*ProjectsController](), htmx.Config{Container: ct}))
+func Inject[T Controller]() ControllerFactory {
+	return func() Controller {
+		return &injected[T]{}
+	}
+}
+
+// buildController returns the controller for the request, built by the container of the config
+// if the factory is Inject, and injects the tagged fields of other controllers.
+func buildController(c *fiber.Ctx, ctrl Controller, cfg Config) (Controller, error) {
+	if cfg.Container == nil {
+		if _, ok := ctrl.(injectedController); ok {
+			return nil, ErrNoContainer
+		}
+
+		return ctrl, nil
+	}
+
+	s := cfg.Container.Scope(c)
+
+	if i, ok := ctrl.(injectedController); ok {
+		return s.controller(i.controllerType())
+	}
+
+	return ctrl, s.Inject(ctrl)
+}
+
+// probeController returns a controller of the type that the factory creates to check its interfaces.
+func probeController(factory ControllerFactory) Controller {
+	ctrl := factory()
+
+	if i, ok := ctrl.(injectedController); ok {
+		if p, ok := reflect.Zero(i.controllerType()).Interface().(Controller); ok {
+			return p
+		}
+	}
+
+	return ctrl
+}
@@ -0,0 +1,297 @@
+package htmx_test
+
+import (
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	htmx "github.com/zeiss/fiber-htmx"
+	"golang.org/x/text/language"
+)
+
+type greeter interface {
+	Greet(name string) string
+}
+
+type englishGreeter struct{}
+
+func (englishGreeter) Greet(name string) string {
+	return "Hello " + name
+}
+
+type fakeGreeter struct{}
+
+func (fakeGreeter) Greet(name string) string {
+	return "Fake " + name
+}
+
+type requestService struct {
+	path   string
+	locale language.Tag
+}
+
+func newRequestService(c *fiber.Ctx, locale language.Tag) *requestService {
+	return &requestService{path: c.Path(), locale: locale}
+}
+
+type ctorController struct {
+	htmx.DefaultController
+	greeter greeter
+	svc     *requestService
+}
+
+func newCtorController(g greeter, svc *requestService) *ctorController {
+	return &ctorController{greeter: g, svc: svc}
+}
+
+func (c *ctorController) Get() error {
+	return c.Ctx().SendString(c.greeter.Greet(c.svc.path) + " " + c.svc.locale.String())
+}
+
+type tagController struct {
+	htmx.DefaultController
+	Greeter greeter         `inject:""`
+	Service *requestService `inject:""`
+	Tx      htmx.CurrentTx  `inject:""`
+	Missing *ctorController `inject:"optional"`
+}
+
+func (c *tagController) Get() error {
+	return c.Ctx().SendString(c.Greeter.Greet(c.Service.path))
+}
+
+func newTestContainer(t *testing.T) *htmx.Container {
+	t.Helper()
+
+	ct := htmx.NewContainer()
+	require.NoError(t, ct.Singleton(func() greeter { return englishGreeter{} }))
+	require.NoError(t, ct.Scoped(newRequestService))
+
+	return ct
+}
+
+func TestContainerInject(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		factory  htmx.ControllerFactory
+		register func(ct *htmx.Container) error
+		override any
+		body     string
+	}{
+		{
+			name:     "constructor",
+			factory:  htmx.Inject[*ctorController](),
+			register: func(ct *htmx.Container) error { return ct.Scoped(newCtorController) },
+			body:     "Hello /projects de-CH",
+		},
+		{
+			name:    "struct tags",
+			factory: htmx.Inject[*tagController](),
+			body:    "Hello /projects",
+		},
+		{
+			name:    "struct tags of factory",
+			factory: func() htmx.Controller { return &tagController{} },
+			body:    "Hello /projects",
+		},
+		{
+			name:     "override",
+			factory:  htmx.Inject[*tagController](),
+			override: greeter(fakeGreeter{}),
+			body:     "Fake /projects",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ct := newTestContainer(t)
+
+			if test.register != nil {
+				require.NoError(t, test.register(ct))
+			}
+
+			if test.override != nil {
+				require.NoError(t, ct.Override(func() greeter { return test.override.(greeter) }))
+			}
+
+			app := fiber.New()
+			app.Get("/projects", htmx.NewControllerHandler(test.factory, htmx.Config{Container: ct}))
+
+			req := httptest.NewRequest(fiber.MethodGet, "/projects", nil)
+			req.Header.Set(fiber.HeaderAcceptLanguage, "de-CH, en;q=0.8")
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+			assert.Equal(t, test.body, string(body))
+		})
+	}
+}
+
+func TestContainerResolve(t *testing.T) {
+	t.Parallel()
+
+	ct := newTestContainer(t)
+
+	var first, second *requestService
+	var g greeter
+
+	app := fiber.New()
+	app.Use(htmx.NewContainerHandler(ct))
+	app.Get("/", func(c *fiber.Ctx) (err error) {
+		first, err = htmx.Resolve[*requestService](c)
+		if err != nil {
+			return err
+		}
+
+		second, err = htmx.Resolve[*requestService](c)
+		if err != nil {
+			return err
+		}
+
+		g, err = htmx.Resolve[greeter](c)
+
+		return err
+	})
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Same(t, first, second)
+	assert.Equal(t, "Hello you", g.Greet("you"))
+}
+
+func TestContainerErrors(t *testing.T) {
+	t.Parallel()
+
+	type a struct{}
+	type b struct{}
+
+	tests := []struct {
+		name     string
+		register func(ct *htmx.Container) error
+		resolve  func(c *fiber.Ctx) error
+		err      error
+	}{
+		{
+			name: "not found",
+			resolve: func(c *fiber.Ctx) error {
+				_, err := htmx.Resolve[*a](c)
+				return err
+			},
+			err: htmx.ErrServiceNotFound,
+		},
+		{
+			name: "exists",
+			register: func(ct *htmx.Container) error {
+				return ct.Singleton(func() greeter { return fakeGreeter{} })
+			},
+			err: htmx.ErrServiceExists,
+		},
+		{
+			name: "constructor",
+			register: func(ct *htmx.Container) error {
+				return ct.Singleton(func() {})
+			},
+			err: htmx.ErrServiceConstructor,
+		},
+		{
+			name: "cycle",
+			register: func(ct *htmx.Container) error {
+				return errors.Join(
+					ct.Scoped(func(*b) *a { return &a{} }),
+					ct.Scoped(func(*a) *b { return &b{} }),
+				)
+			},
+			resolve: func(c *fiber.Ctx) error {
+				_, err := htmx.Resolve[*a](c)
+				return err
+			},
+			err: htmx.ErrServiceCycle,
+		},
+		{
+			name: "scope",
+			register: func(ct *htmx.Container) error {
+				return ct.Singleton(func(*requestService) *a { return &a{} })
+			},
+			resolve: func(c *fiber.Ctx) error {
+				_, err := htmx.Resolve[*a](c)
+				return err
+			},
+			err: htmx.ErrServiceScope,
+		},
+		{
+			name: "constructor error",
+			register: func(ct *htmx.Container) error {
+				return ct.Scoped(func() (*a, error) { return nil, fiber.ErrTeapot })
+			},
+			resolve: func(c *fiber.Ctx) error {
+				_, err := htmx.Resolve[*a](c)
+				return err
+			},
+			err: fiber.ErrTeapot,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ct := newTestContainer(t)
+
+			if test.register != nil {
+				err := test.register(ct)
+				if test.resolve == nil {
+					require.ErrorIs(t, err, test.err)
+					return
+				}
+
+				require.NoError(t, err)
+			}
+
+			var err error
+
+			app := fiber.New()
+			app.Use(htmx.NewContainerHandler(ct))
+			app.Get("/", func(c *fiber.Ctx) error {
+				err = test.resolve(c)
+				return nil
+			})
+
+			_, e := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+			require.NoError(t, e)
+			require.ErrorIs(t, err, test.err)
+		})
+	}
+}
+
+func TestInjectWithoutContainer(t *testing.T) {
+	t.Parallel()
+
+	var handled error
+
+	app := fiber.New()
+	app.Get("/", htmx.NewControllerHandler(htmx.Inject[*tagController](), htmx.Config{
+		ErrorHandler: func(c *fiber.Ctx, err error) error {
+			handled = err
+			return c.SendStatus(fiber.StatusInternalServerError)
+		},
+	}))
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusInternalServerError, resp.StatusCode)
+	require.ErrorIs(t, handled, htmx.ErrNoContainer)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"sync/atomic"
 
 	"github.com/gofiber/fiber/v2"
 	goth "github.com/zeiss/fiber-goth"
@@ -45,6 +47,8 @@ type Controller interface {
 }
 
 // TransactionController is the interface for a controller that also does database transactions.
+//
+// Deprecated: use TxController instead, the controller handler only manages the transactions of a TxController.
 type TransactionController interface {
 	// Returns the transaction.
 	Tx() *gorm.DB
@@ -56,6 +60,20 @@ type TransactionController interface {
 	Rollback() error
 }
 
+// TxController is the interface for a controller whose database transaction is managed by the controller handler.
+// The controller handler begins the transaction before Prepare, commits it after Finalize
+// and rolls it back on error or panic if a database is configured.
+type TxController interface {
+	// Returns the transaction.
+	Tx() *gorm.DB
+	// BeginTx begins a transaction, or a savepoint if the connection is already a transaction.
+	BeginTx(*gorm.DB, ...*sql.TxOptions) error
+	// Commit commits the transaction.
+	Commit() error
+	// Rollback rolls back the transaction.
+	Rollback() error
+}
+
 var _ Controller = (*DefaultController)(nil)
 
 // UnimplementedController is not to be used anymore.
@@ -121,8 +139,9 @@ func (c *DefaultController) Options() error {
 }
 
 // Error is called when an error occurs.
+// The error is passed on to the error handler of the controller handler.
 func (c *DefaultController) Error(err error) error {
-	return fiber.NewError(fiber.StatusInternalServerError)
+	return err
 }
 
 // Head is called when the controller is executed with the HEAD method.
@@ -170,6 +189,11 @@ func (c *DefaultController) ValuesBool(key any, value ...any) (val bool) {
 	return c.ctx.Locals(key, value...).(bool)
 }
 
+// HxRequest returns the parsed htmx request headers.
+func (c *DefaultController) HxRequest() HxRequest {
+	return RequestFromContext(c.ctx)
+}
+
 // Session is a helper function to get the session from the context.
 func (c *DefaultController) Session() adapters.GothSession {
 	session, err := goth.SessionFromContext(c.Ctx())
@@ -213,6 +237,16 @@ func (c *DefaultController) Redirect(path string) error {
 	return nil
 }
 
+// Location does a client-side redirect that does not do a full page reload.
+func (c *DefaultController) Location(opts LocationOptions) error {
+	return Location(c.ctx, opts)
+}
+
+// LocationPath does a client-side redirect to the given path that does not do a full page reload.
+func (c *DefaultController) LocationPath(path string) error {
+	return Location(c.ctx, LocationOptions{Path: path})
+}
+
 // Render renders a component.
 func (c *DefaultController) Render(node Node, opt ...RenderOpt) error {
 	return RenderComp(c.ctx, node, opt...)
@@ -233,7 +267,13 @@ func (c *DefaultController) Reset() {
 	c.ctx = nil
 }
 
-var _ TransactionController = (*DefaultTransactionController)(nil)
+var (
+	_ TransactionController = (*DefaultTransactionController)(nil)
+	_ TxController          = (*DefaultTransactionController)(nil)
+)
+
+// savepoints is a counter for unique savepoint names.
+var savepoints atomic.Uint64
 
 // NewTransactionController returns a new transaction controller.
 func NewTransactionController() *DefaultTransactionController {
@@ -245,12 +285,34 @@ func NewTransactionController() *DefaultTransactionController {
 // DefaultTransactionController is the interface for the htmx transaction controller.
 type DefaultTransactionController struct {
 	*DefaultController
-	tx *gorm.DB
+	tx        *gorm.DB
+	savepoint string
 }
 
 // Begin begins a transaction.
+//
+// Deprecated: use BeginTx instead.
 func (c *DefaultTransactionController) Begin(conn *gorm.DB) error {
-	tx := conn.Begin()
+	return c.BeginTx(conn)
+}
+
+// BeginTx begins a transaction with the options.
+// A savepoint is created instead if the connection is already a transaction.
+func (c *DefaultTransactionController) BeginTx(conn *gorm.DB, opts ...*sql.TxOptions) error {
+	if _, ok := conn.Statement.ConnPool.(gorm.TxCommitter); ok {
+		name := fmt.Sprintf("sp_%d", savepoints.Add(1))
+
+		if err := conn.SavePoint(name).Error; err != nil {
+			return err
+		}
+
+		c.tx = conn
+		c.savepoint = name
+
+		return nil
+	}
+
+	tx := conn.Begin(opts...)
 	if tx.Error != nil {
 		return tx.Error
 	}
@@ -260,11 +322,19 @@ func (c *DefaultTransactionController) Begin(conn *gorm.DB) error {
 }
 
 // Commit commits the transaction.
-func (c *DefaultTransactionController) Commmit() error {
+// A savepoint is kept to be committed with the outer transaction.
+func (c *DefaultTransactionController) Commit() error {
 	if c.tx == nil {
 		return nil
 	}
 
+	if c.savepoint != "" {
+		c.tx = nil
+		c.savepoint = ""
+
+		return nil
+	}
+
 	c.tx.Commit()
 	if err := c.tx.Error; err != nil {
 		return err
@@ -275,12 +345,27 @@ func (c *DefaultTransactionController) Commmit() error {
 	return nil
 }
 
-// Rollback rolls back the transaction.
+// Commmit commits the transaction.
+//
+// Deprecated: use Commit instead.
+func (c *DefaultTransactionController) Commmit() error {
+	return c.Commit()
+}
+
+// Rollback rolls back the transaction, or to the savepoint of a nested transaction.
 func (c *DefaultTransactionController) Rollback() error {
 	if c.tx == nil {
 		return nil
 	}
 
+	if c.savepoint != "" {
+		err := c.tx.RollbackTo(c.savepoint).Error
+		c.tx = nil
+		c.savepoint = ""
+
+		return err
+	}
+
 	c.tx.Rollback()
 	if err := c.tx.Error; err != nil && !errors.Is(err, sql.ErrTxDone) {
 		return err
@@ -290,6 +375,13 @@ func (c *DefaultTransactionController) Rollback() error {
 	return nil
 }
 
+// Reset resets the controller.
+func (c *DefaultTransactionController) Reset() {
+	c.DefaultController.Reset()
+	c.tx = nil
+	c.savepoint = ""
+}
+
 // Txn returns the transaction.
 func (c *DefaultTransactionController) Tx() *gorm.DB {
 	if c.tx != nil {
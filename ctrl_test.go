@@ -1,14 +1,143 @@
 package htmx_test
 
 import (
+	"net/http/httptest"
 	"testing"
 
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
 	htmx "github.com/zeiss/fiber-htmx"
+	"gorm.io/gorm"
 )
 
 func TestNewTransactionControl(t *testing.T) {
 	ctrl := htmx.NewTransactionController()
 	require.NotNil(t, ctrl)
 	require.Implements(t, (*htmx.TransactionController)(nil), ctrl)
+	require.Implements(t, (*htmx.TxController)(nil), ctrl)
+}
+
+// legacyTxController implements the deprecated TransactionController interface.
+type legacyTxController struct {
+	htmx.DefaultController
+}
+
+func (l *legacyTxController) Tx() *gorm.DB           { return nil }
+func (l *legacyTxController) Begin(_ *gorm.DB) error { return nil }
+func (l *legacyTxController) Commmit() error         { return nil }
+func (l *legacyTxController) Rollback() error        { return nil }
+
+func TestTransactionControllerCompat(t *testing.T) {
+	t.Parallel()
+
+	require.Implements(t, (*htmx.TransactionController)(nil), &legacyTxController{})
+	require.NotImplements(t, (*htmx.TxController)(nil), &legacyTxController{})
+}
+
+type pooledController struct {
+	htmx.DefaultController
+	calls int
+}
+
+func (p *pooledController) Get() error {
+	p.calls++
+	return p.Ctx().SendString("ok")
+}
+
+func (p *pooledController) Reset() {
+	p.DefaultController.Reset()
+	p.calls = 0
+}
+
+type leakyController struct {
+	pooledController
+}
+
+func (l *leakyController) Reset() {
+	l.calls = 0
+}
+
+func TestNewPooledControllerHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		factory func() htmx.Controller
+		created int
+	}{
+		{
+			name:    "reused",
+			factory: func() htmx.Controller { return &pooledController{} },
+			created: 1,
+		},
+		{
+			name:    "not reset",
+			factory: func() htmx.Controller { return &leakyController{} },
+			created: 3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var created []htmx.Controller
+
+			app := fiber.New()
+			app.Get("/", htmx.NewPooledControllerHandler(func() htmx.Controller {
+				ctrl := test.factory()
+				created = append(created, ctrl)
+
+				return ctrl
+			}))
+
+			for range 3 {
+				resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+				require.NoError(t, err)
+				assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+			}
+
+			assert.Len(t, created, test.created)
+		})
+	}
+}
+
+func TestNewPooledControllerHandlerInject(t *testing.T) {
+	t.Parallel()
+
+	assert.Panics(t, func() {
+		htmx.NewPooledControllerHandler(htmx.Inject[*pooledController]())
+	})
+}
+
+func benchmarkControllerHandler(b *testing.B, handler fiber.Handler) {
+	app := fiber.New()
+	app.Get("/", handler)
+
+	h := app.Handler()
+
+	ctx := &fasthttp.RequestCtx{}
+	ctx.Request.Header.SetMethod(fiber.MethodGet)
+	ctx.Request.SetRequestURI("/")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		h(ctx)
+	}
+}
+
+func Benchmark_ControllerHandler(b *testing.B) {
+	benchmarkControllerHandler(b, htmx.NewControllerHandler(func() htmx.Controller {
+		return &pooledController{}
+	}))
+}
+
+func Benchmark_PooledControllerHandler(b *testing.B) {
+	benchmarkControllerHandler(b, htmx.NewPooledControllerHandler(func() htmx.Controller {
+		return &pooledController{}
+	}))
 }
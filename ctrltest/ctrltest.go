@@ -0,0 +1,353 @@
+package ctrltest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	goth "github.com/zeiss/fiber-goth"
+	"github.com/zeiss/fiber-goth/adapters"
+	htmx "github.com/zeiss/fiber-htmx"
+	"golang.org/x/net/html"
+)
+
+// Request is a request to a controller under test.
+type Request struct {
+	method  string
+	target  string
+	route   string
+	body    io.Reader
+	header  http.Header
+	locals  map[any]any
+	session *adapters.GothSession
+}
+
+// NewRequest returns a new request with the method to the target URL.
+func NewRequest(method, target string) *Request {
+	return &Request{
+		method: method,
+		target: target,
+		header: make(http.Header),
+		locals: make(map[any]any),
+	}
+}
+
+// Get returns a new GET request to the target URL.
+func Get(target string) *Request {
+	return NewRequest(fiber.MethodGet, target)
+}
+
+// Post returns a new POST request to the target URL with the form values.
+func Post(target string, form url.Values) *Request {
+	return NewRequest(fiber.MethodPost, target).Form(form)
+}
+
+// Route sets the route of the controller, e.g. /projects/:id, the path of the target URL by default.
+func (r *Request) Route(route string) *Request {
+	r.route = route
+	return r
+}
+
+// Header sets a request header.
+func (r *Request) Header(key, value string) *Request {
+	r.header.Set(key, value)
+	return r
+}
+
+// Htmx marks the request as an htmx request.
+func (r *Request) Htmx() *Request {
+	return r.Header(htmx.HxRequestHeaderRequest.String(), "true")
+}
+
+// Target sets the id of the target element of an htmx request.
+func (r *Request) Target(id string) *Request {
+	return r.Htmx().Header(htmx.HxRequestHeaderTarget.String(), id)
+}
+
+// Trigger sets the id of the triggered element of an htmx request.
+func (r *Request) Trigger(id string) *Request {
+	return r.Htmx().Header(htmx.HxRequestHeaderTrigger.String(), id)
+}
+
+// TriggerName sets the name of the triggered element of an htmx request.
+func (r *Request) TriggerName(name string) *Request {
+	return r.Htmx().Header(htmx.HxRequestHeaderTriggerName.String(), name)
+}
+
+// CurrentURL sets the current URL of the browser of an htmx request.
+func (r *Request) CurrentURL(u string) *Request {
+	return r.Htmx().Header(htmx.HxRequestHeaderCurrentURL.String(), u)
+}
+
+// Prompt sets the response of the user to an hx-prompt.
+func (r *Request) Prompt(value string) *Request {
+	return r.Htmx().Header(htmx.HxRequestHeaderPrompt.String(), value)
+}
+
+// Boosted marks the request as a boosted htmx request.
+func (r *Request) Boosted() *Request {
+	return r.Htmx().Header(htmx.HxRequestHeaderBoosted.String(), "true")
+}
+
+// HistoryRestore marks the request as a history restore request after a miss in the local history cache.
+func (r *Request) HistoryRestore() *Request {
+	return r.Htmx().Header(htmx.HxRequestHeaderHistoryRestoreRequest.String(), "true")
+}
+
+// Form sets the body of the request to the URL encoded form values.
+func (r *Request) Form(values url.Values) *Request {
+	return r.Body(strings.NewReader(values.Encode()), fiber.MIMEApplicationForm)
+}
+
+// JSON sets the body of the request to the JSON encoding of the value.
+func (r *Request) JSON(v any) *Request {
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return r.Body(strings.NewReader(string(b)), fiber.MIMEApplicationJSON)
+}
+
+// Body sets the body of the request with its content type.
+func (r *Request) Body(body io.Reader, contentType string) *Request {
+	r.body = body
+	return r.Header(fiber.HeaderContentType, contentType)
+}
+
+// Local sets a value in the locals of the request.
+func (r *Request) Local(key, value any) *Request {
+	r.locals[key] = value
+	return r
+}
+
+// Session sets the goth session of the request.
+// An empty expiry of the session is set to one hour from now.
+func (r *Request) Session(session adapters.GothSession) *Request {
+	if session.ExpiresAt.IsZero() {
+		session.ExpiresAt = time.Now().Add(time.Hour)
+	}
+
+	r.session = &session
+
+	return r
+}
+
+// sessionAdapter returns the session of the request for the session middleware.
+type sessionAdapter struct {
+	adapters.UnimplementedAdapter
+	session adapters.GothSession
+}
+
+// GetSession returns the session of the request.
+func (a *sessionAdapter) GetSession(_ context.Context, _ string) (adapters.GothSession, error) {
+	return a.session, nil
+}
+
+// RefreshSession returns the session of the request.
+func (a *sessionAdapter) RefreshSession(_ context.Context, session adapters.GothSession) (adapters.GothSession, error) {
+	return session, nil
+}
+
+// Result is the response of a controller under test.
+type Result struct {
+	// Status is the status code of the response.
+	Status int
+	// Header is the header of the response.
+	Header http.Header
+	// Body is the body of the response.
+	Body string
+	// Messages are the messages of the request.
+	Messages htmx.HtmxMessages
+	// Triggers are the decoded events of the HX-Trigger header.
+	Triggers map[string]any
+	// TriggersAfterSwap are the decoded events of the HX-Trigger-After-Swap header.
+	TriggersAfterSwap map[string]any
+	// TriggersAfterSettle are the decoded events of the HX-Trigger-After-Settle header.
+	TriggersAfterSettle map[string]any
+	// Redirect is the value of the HX-Redirect header.
+	Redirect string
+	// Location is the value of the HX-Location header.
+	Location string
+	// PushURL is the value of the HX-Push-Url header.
+	PushURL string
+	// ReplaceURL is the value of the HX-Replace-Url header.
+	ReplaceURL string
+	// Retarget is the value of the HX-Retarget header.
+	Retarget string
+	// Reswap is the value of the HX-Reswap header.
+	Reswap string
+	// Reselect is the value of the HX-Reselect header.
+	Reselect string
+	// Refresh is true if the HX-Refresh header is true.
+	Refresh bool
+
+	t   testing.TB
+	doc *html.Node
+}
+
+// Run runs the request through htmx.NewControllerHandler with the factory and the config
+// and returns the response. The request is parsed and its messages are collected as
+// with htmx.NewHxRequestHandler and htmx.NewHtmxMessageHandler.
+func Run(t testing.TB, factory htmx.ControllerFactory, r *Request, config ...htmx.Config) *Result {
+	t.Helper()
+
+	var messages *htmx.HtmxMessages
+
+	handlers := []fiber.Handler{
+		func(c *fiber.Ctx) error {
+			for k, v := range r.locals {
+				c.Locals(k, v)
+			}
+
+			return c.Next()
+		},
+		htmx.NewHxRequestHandler(),
+		htmx.NewHtmxMessageHandler(),
+		func(c *fiber.Ctx) error {
+			messages = htmx.MessagesFromContext(c)
+			return c.Next()
+		},
+	}
+
+	ctrl := htmx.NewControllerHandler(factory, config...)
+
+	if r.session != nil {
+		ctrl = goth.NewProtectedHandler(ctrl, goth.Config{
+			Adapter: &sessionAdapter{session: *r.session},
+			Extractor: func(c *fiber.Ctx) (string, error) {
+				return r.session.SessionToken, nil
+			},
+		})
+	}
+
+	route := r.route
+	if route == "" {
+		u, err := url.Parse(r.target)
+		if err != nil {
+			t.Fatalf("ctrltest: invalid target %q: %v", r.target, err)
+		}
+
+		route = u.Path
+	}
+
+	app := fiber.New()
+	app.Add(r.method, route, append(handlers, ctrl)...)
+
+	req := httptest.NewRequest(r.method, r.target, r.body)
+	req.Header = r.header.Clone()
+
+	resp, err := app.Test(req, -1)
+	if err != nil {
+		t.Fatalf("ctrltest: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("ctrltest: %v", err)
+	}
+
+	res := &Result{
+		Status:              resp.StatusCode,
+		Header:              resp.Header,
+		Body:                string(body),
+		Triggers:            decodeTriggers(t, resp.Header.Get(htmx.HXTrigger.String())),
+		TriggersAfterSwap:   decodeTriggers(t, resp.Header.Get(htmx.HXTriggerAfterSwap.String())),
+		TriggersAfterSettle: decodeTriggers(t, resp.Header.Get(htmx.HXTriggerAfterSettle.String())),
+		Redirect:            resp.Header.Get(htmx.HXRedirect.String()),
+		Location:            resp.Header.Get(htmx.HXLocation.String()),
+		PushURL:             resp.Header.Get(htmx.HXPushUrl.String()),
+		ReplaceURL:          resp.Header.Get(htmx.HXReplaceUrl.String()),
+		Retarget:            resp.Header.Get(htmx.HXRetarget.String()),
+		Reswap:              resp.Header.Get(htmx.HXReswap.String()),
+		Reselect:            resp.Header.Get(htmx.HXReselect.String()),
+		Refresh:             resp.Header.Get(htmx.HXRefresh.String()) == "true",
+		t:                   t,
+	}
+
+	if messages != nil {
+		res.Messages = *messages
+	}
+
+	return res
+}
+
+// decodeTriggers decodes the events of a trigger header, the details of events without details are nil.
+func decodeTriggers(t testing.TB, header string) map[string]any {
+	t.Helper()
+
+	triggers := map[string]any{}
+
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return triggers
+	}
+
+	if !strings.HasPrefix(header, "{") {
+		for _, name := range strings.Split(header, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				triggers[name] = nil
+			}
+		}
+
+		return triggers
+	}
+
+	if err := json.Unmarshal([]byte(header), &triggers); err != nil {
+		t.Fatalf("ctrltest: invalid trigger header %q: %v", header, err)
+	}
+
+	return triggers
+}
+
+// Triggered returns true if the event is triggered by the HX-Trigger header.
+func (r *Result) Triggered(name string) bool {
+	_, ok := r.Triggers[name]
+	return ok
+}
+
+// Document returns the root of the parsed body.
+func (r *Result) Document() Element {
+	r.t.Helper()
+
+	if r.doc == nil {
+		doc, err := html.Parse(strings.NewReader(r.Body))
+		if err != nil {
+			r.t.Fatalf("ctrltest: invalid body: %v", err)
+		}
+
+		r.doc = doc
+	}
+
+	return Element{Node: r.doc, t: r.t}
+}
+
+// Find returns the elements of the body that match the selector.
+func (r *Result) Find(selector string) []Element {
+	r.t.Helper()
+
+	return r.Document().Find(selector)
+}
+
+// First returns the first element of the body that matches the selector.
+// The test fails if no element matches.
+func (r *Result) First(selector string) Element {
+	r.t.Helper()
+
+	return r.Document().First(selector)
+}
+
+// Has returns true if an element of the body matches the selector.
+func (r *Result) Has(selector string) bool {
+	r.t.Helper()
+
+	return len(r.Find(selector)) > 0
+}
@@ -0,0 +1,89 @@
+package ctrltest_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/zeiss/fiber-goth/adapters"
+	htmx "github.com/zeiss/fiber-htmx"
+	"github.com/zeiss/fiber-htmx/ctrltest"
+)
+
+type tenantKey struct{}
+
+type harnessController struct {
+	htmx.DefaultController
+}
+
+func (h *harnessController) Get() error {
+	tenant, _ := h.Values(tenantKey{}).(string)
+	user := h.Session().UserID.String()
+
+	if h.HxRequest().Target == "list" {
+		htmx.ReplaceURL(h.Ctx(), "/projects/"+h.Ctx().Params("id"))
+	}
+
+	return h.Render(htmx.Div(
+		htmx.ID("project"),
+		htmx.ClassNames{"card": true, "active": true},
+		htmx.H1(htmx.Text("Project "+h.Ctx().Params("id"))),
+		htmx.Ul(
+			htmx.Li(htmx.Attribute("data-tenant", tenant), htmx.Text(tenant)),
+			htmx.Li(htmx.Text(user)),
+		),
+	))
+}
+
+func (h *harnessController) Post() error {
+	h.Messages(htmx.HtmxMessage{Message: "Saved " + h.Ctx().FormValue("name"), Tags: "success"})
+
+	err := htmx.TriggerEvent(h.Ctx(), "saved", map[string]string{"name": h.Ctx().FormValue("name")})
+	if err != nil {
+		return err
+	}
+
+	htmx.Redirect(h.Ctx(), "/projects")
+
+	return nil
+}
+
+func TestCtrlTest(t *testing.T) {
+	t.Parallel()
+
+	factory := func() htmx.Controller { return &harnessController{} }
+
+	t.Run("render", func(t *testing.T) {
+		t.Parallel()
+
+		user := uuid.New()
+
+		res := ctrltest.Run(t, factory, ctrltest.Get("/projects/42").
+			Route("/projects/:id").
+			Target("list").
+			Local(tenantKey{}, "acme").
+			Session(adapters.GothSession{UserID: user}))
+
+		assert.Equal(t, fiber.StatusOK, res.Status)
+		assert.Equal(t, "/projects/42", res.ReplaceURL)
+		assert.Equal(t, "Project 42", res.First("div#project.card > h1").Text())
+		assert.Equal(t, "acme", res.First("li[data-tenant=acme]").Text())
+		assert.Equal(t, user.String(), res.Find("ul li")[1].Text())
+		assert.Len(t, res.Find("h1, li"), 3)
+		assert.False(t, res.Has("#project > li"))
+	})
+
+	t.Run("post", func(t *testing.T) {
+		t.Parallel()
+
+		res := ctrltest.Run(t, factory, ctrltest.Post("/projects", url.Values{"name": {"Apollo"}}).Htmx())
+
+		assert.Equal(t, fiber.StatusOK, res.Status)
+		assert.Equal(t, "/projects", res.Redirect)
+		assert.Equal(t, htmx.HtmxMessages{{Message: "Saved Apollo", Tags: "success"}}, res.Messages)
+		assert.True(t, res.Triggered("saved"))
+		assert.Equal(t, map[string]any{"name": "Apollo"}, res.Triggers["saved"])
+	})
+}
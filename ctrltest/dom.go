@@ -0,0 +1,322 @@
+package ctrltest
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+)
+
+// Element is an element of the parsed body of a response.
+type Element struct {
+	*html.Node
+
+	t testing.TB
+}
+
+// Attr returns the value of the attribute and whether the element has the attribute.
+func (e Element) Attr(name string) (string, bool) {
+	for _, a := range e.Node.Attr {
+		if a.Key == name {
+			return a.Val, true
+		}
+	}
+
+	return "", false
+}
+
+// AttrOr returns the value of the attribute or the fallback if the element has no such attribute.
+func (e Element) AttrOr(name, fallback string) string {
+	if v, ok := e.Attr(name); ok {
+		return v
+	}
+
+	return fallback
+}
+
+// Text returns the text content of the element and its children with trimmed whitespace.
+func (e Element) Text() string {
+	var b strings.Builder
+
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			b.WriteString(n.Data)
+		}
+
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(e.Node)
+
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// HTML returns the rendered element.
+func (e Element) HTML() string {
+	var b strings.Builder
+
+	if err := html.Render(&b, e.Node); err != nil {
+		e.t.Fatalf("ctrltest: %v", err)
+	}
+
+	return b.String()
+}
+
+// Find returns the descendants of the element that match the selector.
+//
+// Selectors support type, #id, .class, [attr], [attr=value] and [attr~=value]
+// with the descendant and the > child combinator, and lists separated by a comma.
+// Attribute values must not contain whitespace, commas or >.
+func (e Element) Find(query string) []Element {
+	e.t.Helper()
+
+	groups, err := parseSelector(query)
+	if err != nil {
+		e.t.Fatalf("ctrltest: %v", err)
+	}
+
+	var found []Element
+
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type == html.ElementNode && slices.ContainsFunc(groups, func(s selector) bool { return s.match(c, e.Node) }) {
+				found = append(found, Element{Node: c, t: e.t})
+			}
+
+			walk(c)
+		}
+	}
+	walk(e.Node)
+
+	return found
+}
+
+// First returns the first descendant of the element that matches the selector.
+// The test fails if no element matches.
+func (e Element) First(selector string) Element {
+	e.t.Helper()
+
+	found := e.Find(selector)
+	if len(found) == 0 {
+		e.t.Fatalf("ctrltest: no element matches %q", selector)
+	}
+
+	return found[0]
+}
+
+// compound is a sequence of simple selectors that all match a single element.
+type compound struct {
+	tag     string
+	id      string
+	classes []string
+	attrs   []attrSelector
+	child   bool // the element is a child of the element matched by the previous compound
+}
+
+type attrSelector struct {
+	name  string
+	op    string
+	value string
+}
+
+// selector is a chain of compound selectors, the last one matches the element.
+type selector []compound
+
+// parseSelector parses a list of selectors separated by a comma.
+func parseSelector(s string) ([]selector, error) {
+	var groups []selector
+
+	for _, part := range strings.Split(s, ",") {
+		sel, err := parseChain(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector %q: %w", s, err)
+		}
+
+		groups = append(groups, sel)
+	}
+
+	return groups, nil
+}
+
+func parseChain(s string) (selector, error) {
+	var sel selector
+
+	child := false
+
+	for _, tok := range strings.Fields(strings.ReplaceAll(s, ">", " > ")) {
+		if tok == ">" {
+			if len(sel) == 0 || child {
+				return nil, fmt.Errorf("unexpected >")
+			}
+
+			child = true
+
+			continue
+		}
+
+		c, err := parseCompound(tok)
+		if err != nil {
+			return nil, err
+		}
+
+		c.child = child
+		child = false
+
+		sel = append(sel, c)
+	}
+
+	if len(sel) == 0 || child {
+		return nil, fmt.Errorf("empty selector")
+	}
+
+	return sel, nil
+}
+
+func parseCompound(s string) (compound, error) {
+	var c compound
+
+	i := strings.IndexAny(s, "#.[")
+	if i < 0 {
+		i = len(s)
+	}
+
+	c.tag = strings.ToLower(s[:i])
+	if c.tag == "*" {
+		c.tag = ""
+	}
+
+	s = s[i:]
+
+	for s != "" {
+		switch s[0] {
+		case '#', '.':
+			end := strings.IndexAny(s[1:], "#.[")
+			if end < 0 {
+				end = len(s) - 1
+			}
+
+			name := s[1 : end+1]
+			if name == "" {
+				return c, fmt.Errorf("empty name after %c", s[0])
+			}
+
+			if s[0] == '#' {
+				c.id = name
+			} else {
+				c.classes = append(c.classes, name)
+			}
+
+			s = s[end+1:]
+		case '[':
+			end := strings.IndexByte(s, ']')
+			if end < 0 {
+				return c, fmt.Errorf("missing ]")
+			}
+
+			a, err := parseAttr(s[1:end])
+			if err != nil {
+				return c, err
+			}
+
+			c.attrs = append(c.attrs, a)
+			s = s[end+1:]
+		default:
+			return c, fmt.Errorf("unexpected %q", s)
+		}
+	}
+
+	return c, nil
+}
+
+func parseAttr(s string) (attrSelector, error) {
+	for _, op := range []string{"~=", "="} {
+		name, value, ok := strings.Cut(s, op)
+		if !ok {
+			continue
+		}
+
+		if name = strings.TrimSpace(name); name == "" {
+			return attrSelector{}, fmt.Errorf("empty attribute name")
+		}
+
+		return attrSelector{name: name, op: op, value: strings.Trim(strings.TrimSpace(value), `"'`)}, nil
+	}
+
+	if s = strings.TrimSpace(s); s == "" {
+		return attrSelector{}, fmt.Errorf("empty attribute name")
+	}
+
+	return attrSelector{name: s}, nil
+}
+
+// match returns true if the node matches the selector within the root.
+func (sel selector) match(n, root *html.Node) bool {
+	last := len(sel) - 1
+	if !sel[last].match(n) {
+		return false
+	}
+
+	return sel.matchAncestors(n, root, last)
+}
+
+// matchAncestors matches the compounds before i against the ancestors of n within the root.
+func (sel selector) matchAncestors(n, root *html.Node, i int) bool {
+	if i == 0 {
+		return true
+	}
+
+	for p := n.Parent; p != nil && p != root; p = p.Parent {
+		if sel[i-1].match(p) && sel.matchAncestors(p, root, i-1) {
+			return true
+		}
+
+		if sel[i].child {
+			return false
+		}
+	}
+
+	return false
+}
+
+func (c compound) match(n *html.Node) bool {
+	if n.Type != html.ElementNode {
+		return false
+	}
+
+	if c.tag != "" && n.Data != c.tag {
+		return false
+	}
+
+	e := Element{Node: n}
+
+	if c.id != "" && e.AttrOr("id", "") != c.id {
+		return false
+	}
+
+	classes := strings.Fields(e.AttrOr("class", ""))
+	for _, class := range c.classes {
+		if !slices.Contains(classes, class) {
+			return false
+		}
+	}
+
+	for _, a := range c.attrs {
+		v, ok := e.Attr(a.name)
+
+		switch {
+		case !ok:
+			return false
+		case a.op == "=" && v != a.value:
+			return false
+		case a.op == "~=" && !slices.Contains(strings.Fields(v), a.value):
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,236 @@
+package ctrltest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/html"
+)
+
+const domFixture = `<div id="app">
+	<ul id="list" class="items">
+		<li class="item active" data-id="1"><a href="/1">One</a></li>
+		<li class="item" data-id="2"><span><a href="/2">Two</a></span></li>
+	</ul>
+	<form><input name="name" class="input wide" required></form>
+	<p><a href="/about">About</a></p>
+</div>`
+
+func TestParseSelector(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		query string
+		want  []selector
+		err   bool
+	}{
+		{
+			name:  "type",
+			query: "LI",
+			want:  []selector{{{tag: "li"}}},
+		},
+		{
+			name:  "universal",
+			query: "*",
+			want:  []selector{{{}}},
+		},
+		{
+			name:  "compound",
+			query: "li#first.item.active",
+			want:  []selector{{{tag: "li", id: "first", classes: []string{"item", "active"}}}},
+		},
+		{
+			name:  "attributes",
+			query: `input[required][name="name"][class~=wide]`,
+			want: []selector{{{tag: "input", attrs: []attrSelector{
+				{name: "required"},
+				{name: "name", op: "=", value: "name"},
+				{name: "class", op: "~=", value: "wide"},
+			}}}},
+		},
+		{
+			name:  "descendant",
+			query: "ul a",
+			want:  []selector{{{tag: "ul"}, {tag: "a"}}},
+		},
+		{
+			name:  "child",
+			query: "li > a",
+			want:  []selector{{{tag: "li"}, {tag: "a", child: true}}},
+		},
+		{
+			name:  "child without whitespace",
+			query: "li>a",
+			want:  []selector{{{tag: "li"}, {tag: "a", child: true}}},
+		},
+		{
+			name:  "list",
+			query: "ul > li, p a",
+			want:  []selector{{{tag: "ul"}, {tag: "li", child: true}}, {{tag: "p"}, {tag: "a"}}},
+		},
+		{
+			name:  "empty",
+			query: "",
+			err:   true,
+		},
+		{
+			name:  "empty list entry",
+			query: "li,",
+			err:   true,
+		},
+		{
+			name:  "leading child",
+			query: "> a",
+			err:   true,
+		},
+		{
+			name:  "trailing child",
+			query: "li >",
+			err:   true,
+		},
+		{
+			name:  "double child",
+			query: "li > > a",
+			err:   true,
+		},
+		{
+			name:  "empty class",
+			query: "li.",
+			err:   true,
+		},
+		{
+			name:  "unclosed attribute",
+			query: "li[data-id",
+			err:   true,
+		},
+		{
+			name:  "empty attribute",
+			query: "li[=1]",
+			err:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseSelector(test.query)
+			if test.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestElementFind(t *testing.T) {
+	t.Parallel()
+
+	doc, err := html.Parse(strings.NewReader(domFixture))
+	require.NoError(t, err)
+
+	root := Element{Node: doc, t: t}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{
+			name:  "type",
+			query: "a",
+			want:  []string{"/1", "/2", "/about"},
+		},
+		{
+			name:  "descendant",
+			query: "#list a",
+			want:  []string{"/1", "/2"},
+		},
+		{
+			name:  "child",
+			query: "li > a",
+			want:  []string{"/1"},
+		},
+		{
+			name:  "child of descendant",
+			query: "ul span > a",
+			want:  []string{"/2"},
+		},
+		{
+			name:  "descendant of child",
+			query: "ul > li a",
+			want:  []string{"/1", "/2"},
+		},
+		{
+			name:  "class",
+			query: "li.active a",
+			want:  []string{"/1"},
+		},
+		{
+			name:  "attribute",
+			query: "li[data-id=2] a",
+			want:  []string{"/2"},
+		},
+		{
+			name:  "list in document order",
+			query: "p > a, li > a",
+			want:  []string{"/1", "/about"},
+		},
+		{
+			name:  "list without duplicates",
+			query: "a, #list a",
+			want:  []string{"/1", "/2", "/about"},
+		},
+		{
+			name:  "no match",
+			query: "form > a",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, e := range root.Find(test.query) {
+				got = append(got, e.AttrOr("href", ""))
+			}
+
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestElementFindScoped(t *testing.T) {
+	t.Parallel()
+
+	doc, err := html.Parse(strings.NewReader(domFixture))
+	require.NoError(t, err)
+
+	list := Element{Node: doc, t: t}.First("#list")
+
+	tests := []struct {
+		name  string
+		query string
+		want  int
+	}{
+		{name: "descendants", query: "a", want: 2},
+		{name: "ancestors outside the scope do not match", query: "#app a", want: 0},
+		{name: "scope is not matched", query: "ul", want: 0},
+		{name: "input", query: "input[class~=wide]", want: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Len(t, list.Find(test.query), test.want)
+		})
+	}
+}
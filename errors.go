@@ -0,0 +1,265 @@
+package htmx
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	authz "github.com/zeiss/fiber-authz"
+	reload "github.com/zeiss/fiber-reload"
+	"github.com/zeiss/pkg/errorx"
+)
+
+var _ error = (*HTTPError)(nil)
+
+// HTTPError is an error with a status code, a message for the user and an internal cause.
+type HTTPError struct {
+	// Code is the http status code.
+	Code int
+	// Message is the message for the user.
+	Message string
+	// Err is the internal cause that is not shown to the user.
+	Err error
+	// Stack is the stack trace of a recovered panic.
+	Stack []byte
+}
+
+// NewError returns a new error with the status code, the message for the user and an optional internal cause.
+// The message defaults to the status text of the code.
+func NewError(code int, message string, cause ...error) *HTTPError {
+	if message == "" {
+		message = http.StatusText(code)
+	}
+
+	e := &HTTPError{Code: code, Message: message}
+	if len(cause) > 0 {
+		e.Err = cause[0]
+	}
+
+	return e
+}
+
+// Error returns the message and the cause of the error.
+func (e *HTTPError) Error() string {
+	if e.Err == nil {
+		return e.Message
+	}
+
+	return e.Message + ": " + e.Err.Error()
+}
+
+// Unwrap returns the cause of the error.
+func (e *HTTPError) Unwrap() error {
+	return e.Err
+}
+
+// AsHTTPError returns the error as an HTTPError.
+// A fiber.Error keeps its code and message, an authorization denial is forbidden
+// and all other errors are internal server errors.
+func AsHTTPError(err error) *HTTPError {
+	var e *HTTPError
+	if errors.As(err, &e) {
+		return e
+	}
+
+	if errors.Is(err, authz.ErrForbidden) {
+		return NewError(fiber.StatusForbidden, "", err)
+	}
+
+	var fe *fiber.Error
+	if errors.As(err, &fe) && err == error(fe) {
+		return NewError(fe.Code, fe.Message)
+	}
+
+	if fe != nil {
+		return NewError(fe.Code, fe.Message, err)
+	}
+
+	return NewError(fiber.StatusInternalServerError, "", err)
+}
+
+// recoverError converts a recovered panic into an internal server error with the stack trace.
+func recoverError(r any) *HTTPError {
+	err := errorx.RecoverError(r)
+
+	var e *HTTPError
+	if errors.As(err, &e) {
+		return e
+	}
+
+	e = NewError(fiber.StatusInternalServerError, "", err)
+	e.Stack = debug.Stack()
+
+	return e
+}
+
+// ErrorPageFunc returns the component for an error.
+type ErrorPageFunc func(c *fiber.Ctx, err *HTTPError) Node
+
+// ErrorConfig is the configuration of the error handler.
+type ErrorConfig struct {
+	// Pages are the full page components per status code.
+	Pages map[int]ErrorPageFunc
+	// Page is the full page component for all other status codes.
+	//
+	// Optional. Default: DefaultErrorPage
+	Page ErrorPageFunc
+	// Partial is the component for htmx requests that is swapped into Target.
+	// Note that htmx does not swap 4xx and 5xx responses by default, configure
+	// the responseHandling of HtmxConfig or use the response-targets extension.
+	//
+	// Optional. Default: DefaultErrorPartial
+	Partial ErrorPageFunc
+	// Target is the selector that the partial is retargeted to with HX-Retarget.
+	//
+	// Optional. Default: ""
+	Target string
+	// Swap is the swap style of the partial that is set with HX-Reswap.
+	//
+	// Optional. Default: HxSwapInnerHTML
+	Swap HXSwapStyle
+	// Development returns true if the error details should be rendered.
+	//
+	// Optional. Default: reload.IsDevelopment
+	Development func(c *fiber.Ctx) bool
+}
+
+// ErrorConfigDefault is the default config of the error handler.
+var ErrorConfigDefault = ErrorConfig{
+	Pages:   map[int]ErrorPageFunc{},
+	Page:    DefaultErrorPage,
+	Partial: DefaultErrorPartial,
+	Swap:    HxSwapInnerHTML,
+	Development: func(c *fiber.Ctx) bool {
+		return reload.IsDevelopment(c.UserContext())
+	},
+}
+
+// NewErrorHandler returns an error handler that renders error pages,
+// or error partials for htmx requests. It can be used for Config.ErrorHandler
+// and for the ErrorHandler of the fiber app.
+func NewErrorHandler(config ...ErrorConfig) fiber.ErrorHandler {
+	cfg := errorConfigDefault(config...)
+
+	return func(c *fiber.Ctx, err error) error {
+		e := AsHTTPError(err)
+
+		c.Status(e.Code)
+		c.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+
+		var n Node
+
+		kind := RequestFromContext(c).Kind()
+		partial := kind == HxRequestKindPartial || kind == HxRequestKindPrompt
+
+		switch {
+		case cfg.Development(c) && partial:
+			n = ErrorDetails(e)
+		case cfg.Development(c):
+			n = errorDetailsPage(e)
+		case partial:
+			n = cfg.Partial(c, e)
+		case cfg.Pages[e.Code] != nil:
+			n = cfg.Pages[e.Code](c, e)
+		default:
+			n = cfg.Page(c, e)
+		}
+
+		if partial {
+			if cfg.Target != "" {
+				ReTarget(c, cfg.Target)
+			}
+
+			ReSwap(c, cfg.Swap.String())
+		}
+
+		return n.Render(c)
+	}
+}
+
+// DefaultErrorPage is the default full page for errors.
+func DefaultErrorPage(c *fiber.Ctx, err *HTTPError) Node {
+	title := strconv.Itoa(err.Code) + " " + http.StatusText(err.Code)
+
+	return HTML5(
+		HTML5Props{
+			Title: title,
+		},
+		Main(
+			H1(Text(title)),
+			P(Text(err.Message)),
+		),
+	)
+}
+
+// DefaultErrorPartial is the default partial for errors of htmx requests.
+func DefaultErrorPartial(c *fiber.Ctx, err *HTTPError) Node {
+	return Div(
+		RoleAlert(),
+		Text(err.Message),
+	)
+}
+
+// ErrorDetails renders the status, the message, the causes and the stack trace of an error.
+// It is meant for development only as it reveals internal details.
+func ErrorDetails(err *HTTPError) Node {
+	causes := []Node{}
+	for cause := err.Err; cause != nil; cause = errors.Unwrap(cause) {
+		causes = append(causes, Li(Text(fmt.Sprintf("%T: %s", cause, cause.Error()))))
+	}
+
+	return Div(
+		RoleAlert(),
+		H1(Text(strconv.Itoa(err.Code)+" "+http.StatusText(err.Code))),
+		P(Text(err.Message)),
+		If(len(causes) > 0, Ul(causes...)),
+		If(len(err.Stack) > 0, Pre(Text(string(err.Stack)))),
+	)
+}
+
+// errorDetailsPage renders the error details as a full page.
+func errorDetailsPage(err *HTTPError) Node {
+	return HTML5(
+		HTML5Props{
+			Title: strconv.Itoa(err.Code) + " " + http.StatusText(err.Code),
+		},
+		Main(
+			ErrorDetails(err),
+		),
+	)
+}
+
+// Helper function to set default values
+func errorConfigDefault(config ...ErrorConfig) ErrorConfig {
+	if len(config) < 1 {
+		return ErrorConfigDefault
+	}
+
+	// Override default config
+	cfg := config[0]
+
+	if cfg.Pages == nil {
+		cfg.Pages = ErrorConfigDefault.Pages
+	}
+
+	if cfg.Page == nil {
+		cfg.Page = ErrorConfigDefault.Page
+	}
+
+	if cfg.Partial == nil {
+		cfg.Partial = ErrorConfigDefault.Partial
+	}
+
+	if cfg.Swap == "" {
+		cfg.Swap = ErrorConfigDefault.Swap
+	}
+
+	if cfg.Development == nil {
+		cfg.Development = ErrorConfigDefault.Development
+	}
+
+	return cfg
+}
@@ -0,0 +1,266 @@
+package htmx_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authz "github.com/zeiss/fiber-authz"
+	htmx "github.com/zeiss/fiber-htmx"
+)
+
+type initPanicController struct {
+	htmx.DefaultController
+}
+
+func (c *initPanicController) Init(ctx *fiber.Ctx) error {
+	panic("init failed")
+}
+
+type notFoundController struct {
+	htmx.DefaultController
+}
+
+func (c *notFoundController) Get() error {
+	return htmx.NewError(fiber.StatusNotFound, "Project not found", errors.New("record not found"))
+}
+
+type deniedController struct {
+	htmx.DefaultController
+}
+
+func (c *deniedController) GetPrincipial(_ *fiber.Ctx) (authz.AuthzPrincipal, error) {
+	return "alice", nil
+}
+
+func (c *deniedController) GetObject(_ *fiber.Ctx) (authz.AuthzObject, error) {
+	return "project", nil
+}
+
+func (c *deniedController) GetAction(_ *fiber.Ctx) (authz.AuthzAction, error) {
+	return "delete", nil
+}
+
+func (c *deniedController) Get() error {
+	return c.Ctx().SendString("deleted")
+}
+
+func TestErrorHandler(t *testing.T) {
+	t.Parallel()
+
+	errorHandler := htmx.NewErrorHandler(htmx.ErrorConfig{
+		Pages: map[int]htmx.ErrorPageFunc{
+			fiber.StatusNotFound: func(c *fiber.Ctx, err *htmx.HTTPError) htmx.Node {
+				return htmx.H1(htmx.Text("missing: " + err.Message))
+			},
+		},
+		Target: "#errors",
+		Development: func(c *fiber.Ctx) bool {
+			return c.Query("dev") != ""
+		},
+	})
+
+	tests := []struct {
+		name    string
+		path    string
+		headers map[string]string
+		status  int
+		want    string
+		target  string
+		swap    string
+	}{
+		{
+			name:   "page per status",
+			path:   "/projects",
+			status: fiber.StatusNotFound,
+			want:   "<h1>missing: Project not found</h1>",
+		},
+		{
+			name:    "partial",
+			path:    "/projects",
+			headers: map[string]string{"HX-Request": "true"},
+			status:  fiber.StatusNotFound,
+			want:    `<div role="alert">Project not found</div>`,
+			target:  "#errors",
+			swap:    "innerHTML",
+		},
+		{
+			name:   "panic in init",
+			path:   "/panic",
+			status: fiber.StatusInternalServerError,
+			want:   "Internal Server Error",
+		},
+		{
+			name:   "development",
+			path:   "/projects?dev=1",
+			status: fiber.StatusNotFound,
+			want:   "<li>*errors.errorString: record not found</li>",
+		},
+		{
+			name:   "development page",
+			path:   "/projects?dev=1",
+			status: fiber.StatusNotFound,
+			want:   "<title>404 Not Found</title>",
+		},
+		{
+			name:    "development partial",
+			path:    "/projects?dev=1",
+			headers: map[string]string{"HX-Request": "true"},
+			status:  fiber.StatusNotFound,
+			want:    `<div role="alert"><h1>404 Not Found</h1>`,
+			target:  "#errors",
+			swap:    "innerHTML",
+		},
+		{
+			name:   "forbidden",
+			path:   "/denied",
+			status: fiber.StatusForbidden,
+			want:   "<h1>403 Forbidden</h1>",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := htmx.Config{ErrorHandler: errorHandler, AuthzChecker: roleChecker{}}
+
+			app := fiber.New()
+			app.Get("/projects", htmx.NewControllerHandler(func() htmx.Controller { return &notFoundController{} }, cfg))
+			app.Get("/panic", htmx.NewControllerHandler(func() htmx.Controller { return &initPanicController{} }, cfg))
+			app.Get("/denied", htmx.NewControllerHandler(func() htmx.Controller { return &deniedController{} }, cfg))
+
+			req := httptest.NewRequest(fiber.MethodGet, test.path, nil)
+			for k, v := range test.headers {
+				req.Header.Set(k, v)
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.status, resp.StatusCode)
+			assert.Contains(t, string(body), test.want)
+			assert.Equal(t, test.target, resp.Header.Get("HX-Retarget"))
+			assert.Equal(t, test.swap, resp.Header.Get("HX-Reswap"))
+		})
+	}
+}
+
+func TestDefaultErrorHandlerKeepsStatus(t *testing.T) {
+	t.Parallel()
+
+	app := fiber.New()
+	app.Get("/", htmx.NewControllerHandler(func() htmx.Controller { return &notFoundController{} }))
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+	assert.Equal(t, "Project not found", string(body))
+}
+
+func TestDefaultErrorHandlerForbidden(t *testing.T) {
+	t.Parallel()
+
+	app := fiber.New()
+	app.Get("/", htmx.NewControllerHandler(func() htmx.Controller { return &deniedController{} }, htmx.Config{AuthzChecker: roleChecker{}}))
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+	assert.Equal(t, "Forbidden", string(body))
+}
+
+func TestAsHTTPError(t *testing.T) {
+	t.Parallel()
+
+	errCause := errors.New("cause")
+
+	tests := []struct {
+		name  string
+		err   error
+		code  int
+		want  string
+		cause bool
+	}{
+		{
+			name: "fiber error",
+			err:  fiber.ErrNotFound,
+			code: fiber.StatusNotFound,
+			want: "Not Found",
+		},
+		{
+			name:  "wrapped fiber error",
+			err:   fmt.Errorf("project: %w", fiber.ErrNotFound),
+			code:  fiber.StatusNotFound,
+			want:  "Not Found: project: Not Found",
+			cause: true,
+		},
+		{
+			name: "http error",
+			err:  htmx.NewError(fiber.StatusConflict, "exists"),
+			code: fiber.StatusConflict,
+			want: "exists",
+		},
+		{
+			name:  "error",
+			err:   errCause,
+			code:  fiber.StatusInternalServerError,
+			want:  "Internal Server Error: cause",
+			cause: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			e := htmx.AsHTTPError(test.err)
+			assert.Equal(t, test.code, e.Code)
+			assert.Equal(t, test.want, e.Error())
+			assert.Equal(t, test.cause, e.Err != nil)
+		})
+	}
+}
+
+func TestErrorBoundary(t *testing.T) {
+	t.Parallel()
+
+	var b strings.Builder
+
+	err := htmx.Fallback(
+		htmx.ErrorBoundary(func() htmx.Node {
+			return htmx.Div(htmx.NodeFunc(func(w io.Writer) error {
+				panic("render failed")
+			}))
+		}),
+		func(err error) htmx.Node {
+			return htmx.Text("fallback: " + errors.Unwrap(err).Error())
+		},
+	).Render(&b)
+	require.NoError(t, err)
+	assert.Equal(t, "fallback: render failed", b.String())
+
+	err = htmx.ErrorBoundary(func() htmx.Node {
+		panic("build failed")
+	}).Render(io.Discard)
+
+	var e *htmx.HTTPError
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, fiber.StatusInternalServerError, e.Code)
+	assert.NotEmpty(t, e.Stack)
+}
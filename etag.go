@@ -0,0 +1,115 @@
+package htmx
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ETagMode defines how a request with a matching If-None-Match header is answered.
+type ETagMode int
+
+const (
+	// ETagDisabled does not set an ETag.
+	ETagDisabled ETagMode = iota
+	// ETagNotModified answers with 304 Not Modified.
+	ETagNotModified
+	// ETagStopPolling answers with 286 to stop polling and does not swap.
+	ETagStopPolling
+	// ETagNoContent answers with 204 No Content and does not swap.
+	ETagNoContent
+)
+
+// ETag returns a weak ETag for the body.
+func ETag(body []byte) string {
+	h := fnv.New64a()
+	_, _ = h.Write(body)
+
+	return fmt.Sprintf(`W/"%x-%x"`, len(body), h.Sum64())
+}
+
+// NewETagHandler returns a middleware that sets a weak ETag on successful GET and HEAD responses
+// and answers a matching If-None-Match header according to the mode.
+func NewETagHandler(mode ETagMode) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		err := c.Next()
+		if err != nil {
+			return err
+		}
+
+		return sendETag(c, mode, c.Response().Body())
+	}
+}
+
+// sendETag sets the ETag for the body and answers a matching If-None-Match header.
+// The body is sent unchanged if the ETag does not match.
+func sendETag(c *fiber.Ctx, mode ETagMode, body []byte) error {
+	if mode == ETagDisabled || c.Response().StatusCode() != fiber.StatusOK {
+		return nil
+	}
+
+	if c.Method() != fiber.MethodGet && c.Method() != fiber.MethodHead {
+		return nil
+	}
+
+	etag := ETag(body)
+
+	c.Vary(HxRequestHeaderRequest.String(), HxRequestHeaderTarget.String())
+	c.Set(fiber.HeaderETag, etag)
+
+	if !etagMatch(c.Get(fiber.HeaderIfNoneMatch), etag) {
+		return nil
+	}
+
+	c.Response().ResetBody()
+
+	switch mode {
+	case ETagStopPolling:
+		ReSwap(c, HxSwapNone.String())
+		c.Status(StatusStopPolling)
+	case ETagNoContent:
+		ReSwap(c, HxSwapNone.String())
+		c.Status(fiber.StatusNoContent)
+	default:
+		c.Status(fiber.StatusNotModified)
+	}
+
+	return nil
+}
+
+// etagMatch uses the weak comparison to match the ETag against the If-None-Match header.
+func etagMatch(header, etag string) bool {
+	if header == "" {
+		return false
+	}
+
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// renderETag renders the node and sends it with an ETag.
+func renderETag(c *fiber.Ctx, mode ETagMode, n Node) error {
+	if mode == ETagDisabled {
+		return n.Render(c)
+	}
+
+	b := newRenderBuffer(c)
+	if err := n.Render(b); err != nil {
+		return err
+	}
+
+	if _, err := c.Write(b.Bytes()); err != nil {
+		return err
+	}
+
+	return sendETag(c, mode, c.Response().Body())
+}
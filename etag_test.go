@@ -0,0 +1,95 @@
+package htmx_test
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	htmx "github.com/zeiss/fiber-htmx"
+)
+
+func TestETag(t *testing.T) {
+	t.Parallel()
+
+	etag := htmx.ETag([]byte("<div>panel</div>"))
+
+	tests := []struct {
+		name        string
+		mode        htmx.ETagMode
+		ifNoneMatch string
+		status      int
+		body        string
+		reswap      string
+	}{
+		{
+			name:   "no match",
+			mode:   htmx.ETagNotModified,
+			status: fiber.StatusOK,
+			body:   "<div>panel</div>",
+		},
+		{
+			name:        "not modified",
+			mode:        htmx.ETagNotModified,
+			ifNoneMatch: etag,
+			status:      fiber.StatusNotModified,
+		},
+		{
+			name:        "stop polling",
+			mode:        htmx.ETagStopPolling,
+			ifNoneMatch: `"other", ` + etag,
+			status:      htmx.StatusStopPolling,
+			reswap:      "none",
+		},
+		{
+			name:        "no content",
+			mode:        htmx.ETagNoContent,
+			ifNoneMatch: etag,
+			status:      fiber.StatusNoContent,
+			reswap:      "none",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Get("/", htmx.NewCompHandler(htmx.Div(htmx.Text("panel")), htmx.Config{ETag: test.mode}))
+
+			req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+			if test.ifNoneMatch != "" {
+				req.Header.Set(fiber.HeaderIfNoneMatch, test.ifNoneMatch)
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.status, resp.StatusCode)
+			assert.Equal(t, test.body, string(body))
+			assert.Equal(t, etag, resp.Header.Get(fiber.HeaderETag))
+			assert.Equal(t, "HX-Request, HX-Target", resp.Header.Get(fiber.HeaderVary))
+			assert.Equal(t, test.reswap, resp.Header.Get("HX-Reswap"))
+		})
+	}
+}
+
+func TestNewETagHandler(t *testing.T) {
+	t.Parallel()
+
+	app := fiber.New()
+	app.Use(htmx.NewETagHandler(htmx.ETagNotModified))
+	app.Get("/", func(c *fiber.Ctx) error {
+		return c.SendString("panel")
+	})
+
+	req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+	req.Header.Set(fiber.HeaderIfNoneMatch, htmx.ETag([]byte("panel")))
+
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotModified, resp.StatusCode)
+}
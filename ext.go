@@ -1,5 +1,7 @@
 package htmx
 
+import "strings"
+
 // HxExtType is a type for htmx extension types.
 type HxExtType string
 
@@ -7,11 +9,17 @@ const (
 	HXDebug                  HxExtType = "debug"                 // The debug extension allows you to debug htmx requests.
 	HxExtClassTools          HxExtType = "class-tools"           // The class-tools extension allows you to add and remove classes from elements.
 	HxExtClientSideTemplates HxExtType = "client-side-templates" // The client-side-templates extension allows you to use client-side templates to render the response.
+	HxExtHeadSupport         HxExtType = "head-support"          // The head-support extension allows you to merge the head tag of responses.
 	HxExtIgnoreDebug         HxExtType = "ignore:debug"          // The ignore:debug extension allows you to ignore the debug header.
 	HxExtJSON                HxExtType = "json-enc"              // The json-enc extension allows you to specify the JSON encoding for the response.
+	HxExtLoadingStates       HxExtType = "loading-states"        // The loading-states extension allows you to show loading states while a request is in flight.
+	HxExtMorph               HxExtType = "morph"                 // The morph extension allows you to morph the DOM with idiomorph instead of replacing it.
 	HxExtMultiSwap           HxExtType = "multi-swap"            // The multi-swap extension allows you to swap multiple elements in a single response.
 	HxExtPathDeps            HxExtType = "path-deps"             // The path-deps extension allows you to specify the dependencies for a path.
+	HxExtPreload             HxExtType = "preload"               // The preload extension allows you to load content before it is requested.
+	HxExtRemoveMe            HxExtType = "remove-me"             // The remove-me extension allows you to remove elements after a delay.
 	HxExtSSE                 HxExtType = "sse"                   // The sse extension allows you to use Server-Sent Events (SSE) to stream updates to the client.
+	HxExtWS                  HxExtType = "ws"                    // The ws extension allows you to use WebSockets to send and receive messages.
 	HxResponseTargets        HxExtType = "response-targets"      // The response-target extension allows you to specify the target for the response.
 )
 
@@ -20,6 +28,16 @@ func (v HxExtType) String() string {
 	return string(v)
 }
 
+// HxExts sets the hx-ext attribute to enable the given extensions for the element and its children.
+func HxExts(exts ...HxExtType) Node {
+	names := make([]string, 0, len(exts))
+	for _, ext := range exts {
+		names = append(names, ext.String())
+	}
+
+	return HxExt(strings.Join(names, ","))
+}
+
 // MustacheTemplate sets the mustache-template attribute to specify the mustache template for the response.
 func MustacheTemplate(v string) Node {
 	return Attribute("mustache-template", v)
@@ -59,3 +77,18 @@ func HxSSEConnect(url string) Node {
 func HxSSESwap(target string) Node {
 	return Attribute("sse-swap", target)
 }
+
+// HxWS sets the hx-ext attribute to enable the WebSocket extension.
+func HxWS() Node {
+	return HxExt(HxExtWS.String())
+}
+
+// HxWSConnect sets the ws-connect attribute to specify the WebSocket URL.
+func HxWSConnect(url string) Node {
+	return Attribute("ws-connect", url)
+}
+
+// HxWSSend sets the ws-send attribute to send the values of the element over the nearest WebSocket.
+func HxWSSend() Node {
+	return Attribute("ws-send")
+}
@@ -0,0 +1,89 @@
+// Package classtools provides attributes for the htmx class-tools extension.
+// See: https://htmx.org/extensions/class-tools/
+package classtools
+
+import (
+	"strings"
+	"time"
+
+	htmx "github.com/zeiss/fiber-htmx"
+)
+
+// Action is the action of a class operation.
+type Action string
+
+// String returns the string representation of the action.
+func (a Action) String() string {
+	return string(a)
+}
+
+const (
+	ActionAdd    Action = "add"
+	ActionRemove Action = "remove"
+	ActionToggle Action = "toggle"
+)
+
+// Operation is an operation on a class that is applied after a delay.
+type Operation struct {
+	// Action is the action of the operation.
+	Action Action
+	// Class is the class name.
+	Class string
+	// Delay is the delay before the operation is applied.
+	Delay time.Duration
+}
+
+// String returns the string representation of the operation e.g. add foo:1s.
+func (o Operation) String() string {
+	s := o.Action.String() + " " + o.Class
+
+	if o.Delay > 0 {
+		s += ":" + htmx.FormatInterval(o.Delay)
+	}
+
+	return s
+}
+
+// Add returns an operation that adds the class after the delay.
+func Add(class string, delay time.Duration) Operation {
+	return Operation{Action: ActionAdd, Class: class, Delay: delay}
+}
+
+// Remove returns an operation that removes the class after the delay.
+func Remove(class string, delay time.Duration) Operation {
+	return Operation{Action: ActionRemove, Class: class, Delay: delay}
+}
+
+// Toggle returns an operation that toggles the class every delay.
+func Toggle(class string, delay time.Duration) Operation {
+	return Operation{Action: ActionToggle, Class: class, Delay: delay}
+}
+
+// Ext sets the hx-ext attribute to enable the class-tools extension for the element and its children.
+func Ext() htmx.Node {
+	return htmx.HxExts(htmx.HxExtClassTools)
+}
+
+func run(ops ...Operation) string {
+	parts := make([]string, 0, len(ops))
+	for _, o := range ops {
+		parts = append(parts, o.String())
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// Classes sets the classes attribute to apply the operations in sequence.
+func Classes(ops ...Operation) htmx.Node {
+	return htmx.Attribute("classes", run(ops...))
+}
+
+// Parallel sets the classes attribute to apply the runs of operations in parallel.
+func Parallel(runs ...[]Operation) htmx.Node {
+	parts := make([]string, 0, len(runs))
+	for _, r := range runs {
+		parts = append(parts, run(r...))
+	}
+
+	return htmx.Attribute("classes", strings.Join(parts, " & "))
+}
@@ -0,0 +1,56 @@
+package classtools_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	htmx "github.com/zeiss/fiber-htmx"
+	"github.com/zeiss/fiber-htmx/ext/classtools"
+)
+
+func TestClassTools(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		node htmx.Node
+		want string
+	}{
+		{
+			name: "ext",
+			node: classtools.Ext(),
+			want: `<div hx-ext="class-tools"></div>`,
+		},
+		{
+			name: "sequence",
+			node: classtools.Classes(classtools.Add("foo", time.Second), classtools.Remove("bar", 500*time.Millisecond)),
+			want: `<div classes="add foo:1s, remove bar:500ms"></div>`,
+		},
+		{
+			name: "no delay",
+			node: classtools.Classes(classtools.Add("foo", 0)),
+			want: `<div classes="add foo"></div>`,
+		},
+		{
+			name: "parallel",
+			node: classtools.Parallel(
+				[]classtools.Operation{classtools.Add("foo", time.Second), classtools.Remove("foo", 2*time.Second)},
+				[]classtools.Operation{classtools.Toggle("bar", time.Minute)},
+			),
+			want: `<div classes="add foo:1s, remove foo:2s &amp; toggle bar:1m"></div>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var b strings.Builder
+			require.NoError(t, htmx.Div(test.node).Render(&b))
+			assert.Equal(t, test.want, b.String())
+		})
+	}
+}
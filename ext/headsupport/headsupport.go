@@ -0,0 +1,44 @@
+// Package headsupport provides attributes for the htmx head-support extension.
+// See: https://htmx.org/extensions/head-support/
+package headsupport
+
+import htmx "github.com/zeiss/fiber-htmx"
+
+// Mode is the mode of the hx-head attribute.
+type Mode string
+
+// String returns the string representation of the mode.
+func (m Mode) String() string {
+	return string(m)
+}
+
+const (
+	ModeMerge  Mode = "merge"   // merge the head of the response into the current head
+	ModeAppend Mode = "append"  // append the head of the response to the current head
+	ModeReEval Mode = "re-eval" // re-evaluate the element on every request
+)
+
+// Ext sets the hx-ext attribute to enable the head-support extension for the element and its children.
+func Ext() htmx.Node {
+	return htmx.HxExts(htmx.HxExtHeadSupport)
+}
+
+// Head sets the hx-head attribute to the given mode.
+func Head(mode Mode) htmx.Node {
+	return htmx.Attribute("hx-head", mode.String())
+}
+
+// Merge sets the hx-head attribute of the head element to merge the head of the response.
+func Merge() htmx.Node {
+	return Head(ModeMerge)
+}
+
+// Append sets the hx-head attribute of the head element to append the head of the response.
+func Append() htmx.Node {
+	return Head(ModeAppend)
+}
+
+// ReEval sets the hx-head attribute of a head child to re-evaluate it on every request.
+func ReEval() htmx.Node {
+	return Head(ModeReEval)
+}
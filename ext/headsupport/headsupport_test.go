@@ -0,0 +1,52 @@
+package headsupport_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	htmx "github.com/zeiss/fiber-htmx"
+	"github.com/zeiss/fiber-htmx/ext/headsupport"
+)
+
+func TestHeadSupport(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		node htmx.Node
+		want string
+	}{
+		{
+			name: "ext",
+			node: headsupport.Ext(),
+			want: `<div hx-ext="head-support"></div>`,
+		},
+		{
+			name: "merge",
+			node: headsupport.Merge(),
+			want: `<div hx-head="merge"></div>`,
+		},
+		{
+			name: "append",
+			node: headsupport.Append(),
+			want: `<div hx-head="append"></div>`,
+		},
+		{
+			name: "re-eval",
+			node: headsupport.ReEval(),
+			want: `<div hx-head="re-eval"></div>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var b strings.Builder
+			require.NoError(t, htmx.Div(test.node).Render(&b))
+			assert.Equal(t, test.want, b.String())
+		})
+	}
+}
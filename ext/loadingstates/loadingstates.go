@@ -0,0 +1,66 @@
+// Package loadingstates provides attributes for the htmx loading-states extension.
+// See: https://htmx.org/extensions/loading-states/
+package loadingstates
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	htmx "github.com/zeiss/fiber-htmx"
+)
+
+// Ext sets the hx-ext attribute to enable the loading-states extension for the element and its children.
+func Ext() htmx.Node {
+	return htmx.HxExts(htmx.HxExtLoadingStates)
+}
+
+// States sets the data-loading-states attribute to scope the loading states to the element.
+func States() htmx.Node {
+	return htmx.Attribute("data-loading-states")
+}
+
+// Loading sets the data-loading attribute to show the element while loading.
+func Loading() htmx.Node {
+	return htmx.Attribute("data-loading")
+}
+
+// Display sets the data-loading attribute to show the element with the given display value while loading.
+func Display(display string) htmx.Node {
+	return htmx.Attribute("data-loading", display)
+}
+
+// Class sets the data-loading-class attribute to add the classes while loading.
+func Class(classes ...string) htmx.Node {
+	return htmx.Attribute("data-loading-class", strings.Join(classes, " "))
+}
+
+// ClassRemove sets the data-loading-class-remove attribute to remove the classes while loading.
+func ClassRemove(classes ...string) htmx.Node {
+	return htmx.Attribute("data-loading-class-remove", strings.Join(classes, " "))
+}
+
+// Disable sets the data-loading-disable attribute to disable the element while loading.
+func Disable() htmx.Node {
+	return htmx.Attribute("data-loading-disable")
+}
+
+// AriaBusy sets the data-loading-aria-busy attribute to set aria-busy while loading.
+func AriaBusy() htmx.Node {
+	return htmx.Attribute("data-loading-aria-busy")
+}
+
+// Delay sets the data-loading-delay attribute to wait before the loading states are applied.
+func Delay(d time.Duration) htmx.Node {
+	return htmx.Attribute("data-loading-delay", strconv.FormatInt(d.Milliseconds(), 10))
+}
+
+// Target sets the data-loading-target attribute to apply the loading states to the selected elements.
+func Target(selector string) htmx.Node {
+	return htmx.Attribute("data-loading-target", selector)
+}
+
+// Path sets the data-loading-path attribute to apply the loading states only for requests to the path.
+func Path(path string) htmx.Node {
+	return htmx.Attribute("data-loading-path", path)
+}
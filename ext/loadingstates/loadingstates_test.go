@@ -0,0 +1,88 @@
+package loadingstates_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	htmx "github.com/zeiss/fiber-htmx"
+	"github.com/zeiss/fiber-htmx/ext/loadingstates"
+)
+
+func TestLoadingStates(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		node htmx.Node
+		want string
+	}{
+		{
+			name: "ext",
+			node: loadingstates.Ext(),
+			want: `<div hx-ext="loading-states"></div>`,
+		},
+		{
+			name: "states",
+			node: loadingstates.States(),
+			want: `<div data-loading-states></div>`,
+		},
+		{
+			name: "loading",
+			node: loadingstates.Loading(),
+			want: `<div data-loading></div>`,
+		},
+		{
+			name: "display",
+			node: loadingstates.Display("flex"),
+			want: `<div data-loading="flex"></div>`,
+		},
+		{
+			name: "class",
+			node: loadingstates.Class("opacity-50", "cursor-wait"),
+			want: `<div data-loading-class="opacity-50 cursor-wait"></div>`,
+		},
+		{
+			name: "class remove",
+			node: loadingstates.ClassRemove("hidden"),
+			want: `<div data-loading-class-remove="hidden"></div>`,
+		},
+		{
+			name: "disable",
+			node: loadingstates.Disable(),
+			want: `<div data-loading-disable></div>`,
+		},
+		{
+			name: "aria busy",
+			node: loadingstates.AriaBusy(),
+			want: `<div data-loading-aria-busy></div>`,
+		},
+		{
+			name: "delay",
+			node: loadingstates.Delay(time.Second),
+			want: `<div data-loading-delay="1000"></div>`,
+		},
+		{
+			name: "target",
+			node: loadingstates.Target("#spinner"),
+			want: `<div data-loading-target="#spinner"></div>`,
+		},
+		{
+			name: "path",
+			node: loadingstates.Path("/projects"),
+			want: `<div data-loading-path="/projects"></div>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var b strings.Builder
+			require.NoError(t, htmx.Div(test.node).Render(&b))
+			assert.Equal(t, test.want, b.String())
+		})
+	}
+}
@@ -0,0 +1,41 @@
+// Package multiswap provides attributes for the htmx multi-swap extension.
+// See: https://htmx.org/extensions/multi-swap/
+package multiswap
+
+import (
+	"strings"
+
+	htmx "github.com/zeiss/fiber-htmx"
+)
+
+// Target is an element that is swapped from the response.
+type Target struct {
+	// Selector is the id selector of the element e.g. #header.
+	Selector string
+	// Style is the swap style of the element, the default is innerHTML.
+	Style htmx.HXSwapStyle
+}
+
+// String returns the string representation of the target e.g. #header:outerHTML.
+func (t Target) String() string {
+	if t.Style == "" {
+		return t.Selector
+	}
+
+	return t.Selector + ":" + t.Style.String()
+}
+
+// Ext sets the hx-ext attribute to enable the multi-swap extension for the element and its children.
+func Ext() htmx.Node {
+	return htmx.HxExts(htmx.HxExtMultiSwap)
+}
+
+// Swap sets the hx-swap attribute to swap the targets from the response.
+func Swap(targets ...Target) htmx.Node {
+	parts := make([]string, 0, len(targets))
+	for _, t := range targets {
+		parts = append(parts, t.String())
+	}
+
+	return htmx.HxSwap("multi:" + strings.Join(parts, ","))
+}
@@ -0,0 +1,47 @@
+package multiswap_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	htmx "github.com/zeiss/fiber-htmx"
+	"github.com/zeiss/fiber-htmx/ext/multiswap"
+)
+
+func TestMultiSwap(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		node htmx.Node
+		want string
+	}{
+		{
+			name: "ext",
+			node: multiswap.Ext(),
+			want: `<div hx-ext="multi-swap"></div>`,
+		},
+		{
+			name: "default style",
+			node: multiswap.Swap(multiswap.Target{Selector: "#header"}, multiswap.Target{Selector: "#footer"}),
+			want: `<div hx-swap="multi:#header,#footer"></div>`,
+		},
+		{
+			name: "styles",
+			node: multiswap.Swap(multiswap.Target{Selector: "#header", Style: htmx.HxSwapOuterHTML}, multiswap.Target{Selector: "#list", Style: htmx.HxSwapBeforeEnd}),
+			want: `<div hx-swap="multi:#header:outerHTML,#list:beforeend"></div>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var b strings.Builder
+			require.NoError(t, htmx.Div(test.node).Render(&b))
+			assert.Equal(t, test.want, b.String())
+		})
+	}
+}
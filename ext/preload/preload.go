@@ -0,0 +1,47 @@
+// Package preload provides attributes for the htmx preload extension.
+// See: https://htmx.org/extensions/preload/
+package preload
+
+import (
+	htmx "github.com/zeiss/fiber-htmx"
+	"github.com/zeiss/pkg/conv"
+)
+
+// Trigger is the event that starts preloading.
+type Trigger string
+
+// String returns the string representation of the trigger.
+func (t Trigger) String() string {
+	return string(t)
+}
+
+const (
+	MouseDown Trigger = "mousedown" // preload when the user presses the mouse button, this is the default
+	MouseOver Trigger = "mouseover" // preload when the user hovers the element
+	Init      Trigger = "init"      // preload as soon as the page is loaded
+)
+
+// Ext sets the hx-ext attribute to enable the preload extension for the element and its children.
+func Ext() htmx.Node {
+	return htmx.HxExts(htmx.HxExtPreload)
+}
+
+// Preload sets the preload attribute to preload the element on mousedown.
+func Preload() htmx.Node {
+	return htmx.Attribute("preload")
+}
+
+// On sets the preload attribute to preload the element on the given trigger.
+func On(trigger Trigger) htmx.Node {
+	return htmx.Attribute("preload", trigger.String())
+}
+
+// OnEvent sets the preload attribute to preload the element on a custom event.
+func OnEvent(event string) htmx.Node {
+	return htmx.Attribute("preload", event)
+}
+
+// Images sets the preload-images attribute to also preload the images of the preloaded content.
+func Images(v bool) htmx.Node {
+	return htmx.Attribute("preload-images", conv.String(v))
+}
@@ -0,0 +1,62 @@
+package preload_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	htmx "github.com/zeiss/fiber-htmx"
+	"github.com/zeiss/fiber-htmx/ext/preload"
+)
+
+func TestPreload(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		node htmx.Node
+		want string
+	}{
+		{
+			name: "ext",
+			node: preload.Ext(),
+			want: `<div hx-ext="preload"></div>`,
+		},
+		{
+			name: "preload",
+			node: preload.Preload(),
+			want: `<div preload></div>`,
+		},
+		{
+			name: "mouseover",
+			node: preload.On(preload.MouseOver),
+			want: `<div preload="mouseover"></div>`,
+		},
+		{
+			name: "init",
+			node: preload.On(preload.Init),
+			want: `<div preload="init"></div>`,
+		},
+		{
+			name: "event",
+			node: preload.OnEvent("custom"),
+			want: `<div preload="custom"></div>`,
+		},
+		{
+			name: "images",
+			node: preload.Images(true),
+			want: `<div preload-images="true"></div>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var b strings.Builder
+			require.NoError(t, htmx.Div(test.node).Render(&b))
+			assert.Equal(t, test.want, b.String())
+		})
+	}
+}
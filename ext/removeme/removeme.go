@@ -0,0 +1,19 @@
+// Package removeme provides attributes for the htmx remove-me extension.
+// See: https://htmx.org/extensions/remove-me/
+package removeme
+
+import (
+	"time"
+
+	htmx "github.com/zeiss/fiber-htmx"
+)
+
+// Ext sets the hx-ext attribute to enable the remove-me extension for the element and its children.
+func Ext() htmx.Node {
+	return htmx.HxExts(htmx.HxExtRemoveMe)
+}
+
+// RemoveMe sets the remove-me attribute to remove the element after the delay.
+func RemoveMe(delay time.Duration) htmx.Node {
+	return htmx.Attribute("remove-me", htmx.FormatInterval(delay))
+}
@@ -0,0 +1,48 @@
+package removeme_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	htmx "github.com/zeiss/fiber-htmx"
+	"github.com/zeiss/fiber-htmx/ext/removeme"
+)
+
+func TestRemoveMe(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		node htmx.Node
+		want string
+	}{
+		{
+			name: "ext",
+			node: removeme.Ext(),
+			want: `<div hx-ext="remove-me"></div>`,
+		},
+		{
+			name: "seconds",
+			node: removeme.RemoveMe(5 * time.Second),
+			want: `<div remove-me="5s"></div>`,
+		},
+		{
+			name: "milliseconds",
+			node: removeme.RemoveMe(250 * time.Millisecond),
+			want: `<div remove-me="250ms"></div>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var b strings.Builder
+			require.NoError(t, htmx.Div(test.node).Render(&b))
+			assert.Equal(t, test.want, b.String())
+		})
+	}
+}
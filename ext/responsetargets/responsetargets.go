@@ -0,0 +1,60 @@
+// Package responsetargets provides attributes for the htmx response-targets extension.
+// See: https://htmx.org/extensions/response-targets/
+package responsetargets
+
+import (
+	"strconv"
+
+	htmx "github.com/zeiss/fiber-htmx"
+)
+
+// Ext sets the hx-ext attribute to enable the response-targets extension for the element and its children.
+func Ext() htmx.Node {
+	return htmx.HxExts(htmx.HxResponseTargets)
+}
+
+// Code sets the hx-target-{code} attribute to specify the target for the responses matching the code.
+// The code may contain wildcards e.g. 4*, 40* or 4xx.
+func Code(code string, selector string) htmx.Node {
+	return htmx.Attribute("hx-target-"+code, selector)
+}
+
+// Status sets the hx-target-{status} attribute to specify the target for responses with the status code.
+func Status(status int, selector string) htmx.Node {
+	return Code(strconv.Itoa(status), selector)
+}
+
+// Unauthorized sets the hx-target-401 attribute to specify the target for 401 responses.
+func Unauthorized(selector string) htmx.Node {
+	return htmx.HxTarget401(selector)
+}
+
+// Forbidden sets the hx-target-403 attribute to specify the target for 403 responses.
+func Forbidden(selector string) htmx.Node {
+	return htmx.HxTarget403(selector)
+}
+
+// NotFound sets the hx-target-404 attribute to specify the target for 404 responses.
+func NotFound(selector string) htmx.Node {
+	return htmx.HxTarget404(selector)
+}
+
+// InternalServerError sets the hx-target-500 attribute to specify the target for 500 responses.
+func InternalServerError(selector string) htmx.Node {
+	return htmx.HxTarget500(selector)
+}
+
+// ClientError sets the hx-target-4xx attribute to specify the target for 4xx responses.
+func ClientError(selector string) htmx.Node {
+	return htmx.HxTarget4xx(selector)
+}
+
+// ServerError sets the hx-target-5xx attribute to specify the target for 5xx responses.
+func ServerError(selector string) htmx.Node {
+	return htmx.HxTarget5xx(selector)
+}
+
+// Error sets the hx-target-error attribute to specify the target for 4xx and 5xx responses.
+func Error(selector string) htmx.Node {
+	return htmx.HxTargetError(selector)
+}
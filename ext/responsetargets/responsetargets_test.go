@@ -0,0 +1,82 @@
+package responsetargets_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	htmx "github.com/zeiss/fiber-htmx"
+	"github.com/zeiss/fiber-htmx/ext/responsetargets"
+)
+
+func TestResponseTargets(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		node htmx.Node
+		want string
+	}{
+		{
+			name: "ext",
+			node: responsetargets.Ext(),
+			want: `<div hx-ext="response-targets"></div>`,
+		},
+		{
+			name: "wildcard",
+			node: responsetargets.Code("4*", "#errors"),
+			want: `<div hx-target-4*="#errors"></div>`,
+		},
+		{
+			name: "status",
+			node: responsetargets.Status(422, "#form"),
+			want: `<div hx-target-422="#form"></div>`,
+		},
+		{
+			name: "unauthorized",
+			node: responsetargets.Unauthorized("#login"),
+			want: `<div hx-target-401="#login"></div>`,
+		},
+		{
+			name: "forbidden",
+			node: responsetargets.Forbidden("#errors"),
+			want: `<div hx-target-403="#errors"></div>`,
+		},
+		{
+			name: "not found",
+			node: responsetargets.NotFound("#errors"),
+			want: `<div hx-target-404="#errors"></div>`,
+		},
+		{
+			name: "internal server error",
+			node: responsetargets.InternalServerError("#errors"),
+			want: `<div hx-target-500="#errors"></div>`,
+		},
+		{
+			name: "client error",
+			node: responsetargets.ClientError("#errors"),
+			want: `<div hx-target-4xx="#errors"></div>`,
+		},
+		{
+			name: "server error",
+			node: responsetargets.ServerError("#errors"),
+			want: `<div hx-target-5xx="#errors"></div>`,
+		},
+		{
+			name: "error",
+			node: responsetargets.Error("#errors"),
+			want: `<div hx-target-error="#errors"></div>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var b strings.Builder
+			require.NoError(t, htmx.Div(test.node).Render(&b))
+			assert.Equal(t, test.want, b.String())
+		})
+	}
+}
@@ -122,3 +122,15 @@ func Test_HyperScript(t *testing.T) {
 		})
 	}
 }
+
+func Test_HxWS(t *testing.T) {
+	t.Parallel()
+
+	e := htmx.Div(
+		htmx.HxWS(),
+		htmx.HxWSConnect("/chat"),
+		htmx.FormElement(htmx.HxWSSend()),
+	)
+
+	assert.Equal(t, `<div hx-ext="ws" ws-connect="/chat"><form ws-send></form></div>`, e)
+}
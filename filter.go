@@ -0,0 +1,114 @@
+package htmx
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// BeforeFilterFunc is a filter that runs before Prepare of the controller.
+// A returned node is rendered instead of the handler of the controller.
+type BeforeFilterFunc func(c *fiber.Ctx) (Node, error)
+
+// AfterFilterFunc is a filter that runs after the controller with the error of the controller, if any.
+type AfterFilterFunc func(c *fiber.Ctx, err error) error
+
+// ControllerFilter is a named filter of a filter chain.
+type ControllerFilter struct {
+	// Name is the name of the filter.
+	// A filter of a FilterController replaces the filter of the config with the same name.
+	Name string
+	// Methods are the request methods the filter runs for, all methods if empty.
+	Methods []string
+	// Before runs before Prepare of the controller.
+	Before BeforeFilterFunc
+	// After runs after the controller, also if the controller fails.
+	After AfterFilterFunc
+}
+
+// BeforeFilter returns a filter that runs before Prepare of the controller for the given methods.
+func BeforeFilter(name string, fn BeforeFilterFunc, methods ...string) ControllerFilter {
+	return ControllerFilter{Name: name, Methods: methods, Before: fn}
+}
+
+// AfterFilter returns a filter that runs after the controller for the given methods.
+func AfterFilter(name string, fn AfterFilterFunc, methods ...string) ControllerFilter {
+	return ControllerFilter{Name: name, Methods: methods, After: fn}
+}
+
+// FilterController is a controller with its own filters.
+type FilterController interface {
+	// Filters returns the filters of the controller.
+	Filters() []ControllerFilter
+}
+
+// filterChain is the chain of filters of a controller for a single request.
+type filterChain []ControllerFilter
+
+// newFilterChain returns the filters of the config and the controller that run for the request method.
+func newFilterChain(c *fiber.Ctx, ctrl Controller, cfg Config) filterChain {
+	filters := slices.Clone(cfg.Chain)
+
+	if fc, ok := ctrl.(FilterController); ok {
+		for _, f := range fc.Filters() {
+			i := slices.IndexFunc(filters, func(e ControllerFilter) bool { return e.Name != "" && e.Name == f.Name })
+			if i < 0 {
+				filters = append(filters, f)
+				continue
+			}
+
+			filters[i] = f
+		}
+	}
+
+	return slices.DeleteFunc(filters, func(f ControllerFilter) bool {
+		return len(f.Methods) > 0 && !slices.Contains(f.Methods, c.Method())
+	})
+}
+
+// before runs the before filters in order and stops at the first filter that returns a node or an error.
+func (fc filterChain) before(c *fiber.Ctx) (Node, error) {
+	for _, f := range fc {
+		if f.Before == nil {
+			continue
+		}
+
+		n, err := f.Before(c)
+		if err != nil {
+			return nil, fmt.Errorf("htmx: filter %s: %w", f.Name, err)
+		}
+
+		if n != nil {
+			return n, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// after runs all after filters in order with the error of the controller
+// and returns the first error. A panic of a filter is recovered as an error.
+func (fc filterChain) after(c *fiber.Ctx, err error) error {
+	for _, f := range fc {
+		if f.After == nil {
+			continue
+		}
+
+		if e := f.after(c, err); e != nil && err == nil {
+			err = fmt.Errorf("htmx: filter %s: %w", f.Name, e)
+		}
+	}
+
+	return err
+}
+
+func (f ControllerFilter) after(c *fiber.Ctx, err error) (e error) {
+	defer func() {
+		if r := recover(); r != nil {
+			e = recoverError(r)
+		}
+	}()
+
+	return f.After(c, err)
+}
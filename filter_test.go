@@ -0,0 +1,268 @@
+package htmx_test
+
+import (
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	htmx "github.com/zeiss/fiber-htmx"
+)
+
+type filterController struct {
+	htmx.DefaultController
+	calls   *[]string
+	filters []htmx.ControllerFilter
+}
+
+func (f *filterController) Filters() []htmx.ControllerFilter {
+	return f.filters
+}
+
+func (f *filterController) Get() error {
+	*f.calls = append(*f.calls, "get")
+
+	if f.Ctx().Query("fail") != "" {
+		return fiber.ErrTeapot
+	}
+
+	return f.Ctx().SendString("get")
+}
+
+func (f *filterController) Post() error {
+	*f.calls = append(*f.calls, "post")
+
+	return f.Ctx().SendString("post")
+}
+
+func record(calls *[]string, name string) htmx.ControllerFilter {
+	return htmx.ControllerFilter{
+		Name: name,
+		Before: func(c *fiber.Ctx) (htmx.Node, error) {
+			*calls = append(*calls, "before:"+name)
+			return nil, nil
+		},
+		After: func(c *fiber.Ctx, err error) error {
+			if err != nil {
+				*calls = append(*calls, "after:"+name+":"+err.Error())
+				return nil
+			}
+
+			*calls = append(*calls, "after:"+name)
+
+			return nil
+		},
+	}
+}
+
+func TestControllerFilters(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		method string
+		url    string
+		chain  func(calls *[]string) []htmx.ControllerFilter
+		ctrl   func(calls *[]string) []htmx.ControllerFilter
+		calls  []string
+		status int
+		body   string
+	}{
+		{
+			name:   "order",
+			method: fiber.MethodGet,
+			url:    "/",
+			chain: func(calls *[]string) []htmx.ControllerFilter {
+				return []htmx.ControllerFilter{record(calls, "audit"), record(calls, "tenant")}
+			},
+			ctrl: func(calls *[]string) []htmx.ControllerFilter {
+				return []htmx.ControllerFilter{record(calls, "push")}
+			},
+			calls:  []string{"before:audit", "before:tenant", "before:push", "get", "after:audit", "after:tenant", "after:push"},
+			status: fiber.StatusOK,
+			body:   "get",
+		},
+		{
+			name:   "per method",
+			method: fiber.MethodGet,
+			url:    "/",
+			chain: func(calls *[]string) []htmx.ControllerFilter {
+				audit := record(calls, "audit")
+				audit.Methods = []string{fiber.MethodPost}
+
+				return []htmx.ControllerFilter{audit, record(calls, "tenant")}
+			},
+			calls:  []string{"before:tenant", "get", "after:tenant"},
+			status: fiber.StatusOK,
+			body:   "get",
+		},
+		{
+			name:   "replace by name",
+			method: fiber.MethodPost,
+			url:    "/",
+			chain: func(calls *[]string) []htmx.ControllerFilter {
+				return []htmx.ControllerFilter{record(calls, "audit")}
+			},
+			ctrl: func(calls *[]string) []htmx.ControllerFilter {
+				return []htmx.ControllerFilter{
+					htmx.AfterFilter("audit", func(c *fiber.Ctx, err error) error {
+						*calls = append(*calls, "after:custom")
+						return nil
+					}),
+				}
+			},
+			calls:  []string{"post", "after:custom"},
+			status: fiber.StatusOK,
+			body:   "post",
+		},
+		{
+			name:   "after on error",
+			method: fiber.MethodGet,
+			url:    "/?fail=1",
+			chain: func(calls *[]string) []htmx.ControllerFilter {
+				return []htmx.ControllerFilter{record(calls, "audit")}
+			},
+			calls:  []string{"before:audit", "get", "after:audit:I'm a teapot"},
+			status: fiber.StatusTeapot,
+			body:   "I'm a teapot",
+		},
+		{
+			name:   "short circuit",
+			method: fiber.MethodGet,
+			url:    "/",
+			chain: func(calls *[]string) []htmx.ControllerFilter {
+				return []htmx.ControllerFilter{
+					htmx.BeforeFilter("tenant", func(c *fiber.Ctx) (htmx.Node, error) {
+						*calls = append(*calls, "before:tenant")
+						return htmx.Text("select a tenant"), nil
+					}),
+					record(calls, "audit"),
+				}
+			},
+			calls:  []string{"before:tenant", "after:audit"},
+			status: fiber.StatusOK,
+			body:   "select a tenant",
+		},
+		{
+			name:   "before error",
+			method: fiber.MethodGet,
+			url:    "/",
+			chain: func(calls *[]string) []htmx.ControllerFilter {
+				return []htmx.ControllerFilter{
+					htmx.BeforeFilter("tenant", func(c *fiber.Ctx) (htmx.Node, error) {
+						return nil, fiber.ErrNotFound
+					}),
+				}
+			},
+			status: fiber.StatusNotFound,
+			body:   "Not Found",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			calls := []string{}
+
+			var chain []htmx.ControllerFilter
+			if test.chain != nil {
+				chain = test.chain(&calls)
+			}
+
+			app := fiber.New()
+			app.All("/", htmx.NewControllerHandler(func() htmx.Controller {
+				ctrl := &filterController{calls: &calls}
+				if test.ctrl != nil {
+					ctrl.filters = test.ctrl(&calls)
+				}
+
+				return ctrl
+			}, htmx.Config{Chain: chain}))
+
+			resp, err := app.Test(httptest.NewRequest(test.method, test.url, nil))
+			require.NoError(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.status, resp.StatusCode)
+			assert.Equal(t, test.body, string(body))
+
+			if test.calls != nil {
+				assert.Equal(t, test.calls, calls)
+			}
+		})
+	}
+}
+
+func TestControllerFilterAfterError(t *testing.T) {
+	t.Parallel()
+
+	errAudit := errors.New("audit failed")
+
+	app := fiber.New()
+	app.Get("/", htmx.NewControllerHandler(func() htmx.Controller {
+		return &filterController{calls: &[]string{}}
+	}, htmx.Config{
+		Chain: []htmx.ControllerFilter{
+			htmx.AfterFilter("audit", func(c *fiber.Ctx, err error) error {
+				return errAudit
+			}),
+		},
+		ErrorHandler: func(c *fiber.Ctx, err error) error {
+			assert.ErrorIs(t, err, errAudit)
+			return c.Status(fiber.StatusInternalServerError).SendString(err.Error())
+		},
+	}))
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	assert.Equal(t, fiber.StatusInternalServerError, resp.StatusCode)
+	assert.Equal(t, "htmx: filter audit: audit failed", string(body))
+}
+
+func TestControllerFilterAfterPanic(t *testing.T) {
+	t.Parallel()
+
+	var calls []string
+
+	app := fiber.New()
+	app.Get("/", htmx.NewControllerHandler(func() htmx.Controller {
+		return &filterController{calls: &[]string{}}
+	}, htmx.Config{
+		Chain: []htmx.ControllerFilter{
+			htmx.AfterFilter("audit", func(c *fiber.Ctx, err error) error {
+				panic("audit failed")
+			}),
+			htmx.AfterFilter("log", func(c *fiber.Ctx, err error) error {
+				calls = append(calls, "log")
+				return nil
+			}),
+		},
+		ErrorHandler: func(c *fiber.Ctx, err error) error {
+			var e *htmx.HTTPError
+			assert.ErrorAs(t, err, &e)
+
+			return c.Status(fiber.StatusInternalServerError).SendString(err.Error())
+		},
+	}))
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	assert.Equal(t, fiber.StatusInternalServerError, resp.StatusCode)
+	assert.Contains(t, string(body), "htmx: filter audit:")
+	assert.Contains(t, string(body), "audit failed")
+	assert.Equal(t, []string{"log"}, calls)
+}
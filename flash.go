@@ -0,0 +1,428 @@
+package htmx
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	goth "github.com/zeiss/fiber-goth"
+)
+
+// FlashStore keeps the flash of a request for the next request.
+// The flash is the value of the HX-Trigger header with the events of the request.
+type FlashStore interface {
+	// Load returns the flash of the previous request and clears it.
+	Load(c *fiber.Ctx) (string, error)
+	// Save saves the flash for the next request.
+	Save(c *fiber.Ctx, flash string) error
+}
+
+var (
+	// ErrFlashSignature is returned when the signature of a flash cookie is invalid.
+	ErrFlashSignature = errors.New("htmx: invalid flash signature")
+	// ErrFlashTooLarge is returned when a flash exceeds the size limit of a cookie.
+	ErrFlashTooLarge = errors.New("htmx: flash exceeds cookie size")
+	// ErrFlashSecret is returned when a flash cookie is used without a secret.
+	ErrFlashSecret = errors.New("htmx: flash cookie requires a secret")
+)
+
+// MaxFlashCookieSize is the maximum size of the value of a flash cookie.
+// Browsers drop cookies larger than 4096 bytes including the name and the attributes.
+const MaxFlashCookieSize = 3800
+
+var _ FlashStore = (*CookieFlashStore)(nil)
+
+// CookieFlashStore keeps the flash in a cookie that is signed with an HMAC.
+type CookieFlashStore struct {
+	// Name is the name of the cookie.
+	Name string
+	// Path is the path of the cookie.
+	Path string
+	// Secure sets the secure flag of the cookie.
+	Secure bool
+	// Secret is the key of the signature of the cookie.
+	// It is required and must be the same for all instances of an application and across restarts.
+	Secret []byte
+}
+
+// NewCookieFlashStore returns a new cookie store for the flash that is signed with the secret.
+func NewCookieFlashStore(secret []byte) *CookieFlashStore {
+	return &CookieFlashStore{
+		Name:   "htmx-flash",
+		Path:   "/",
+		Secret: secret,
+	}
+}
+
+// Load returns the flash of the previous request and clears it.
+// A flash with an invalid signature is cleared and ErrFlashSignature is returned.
+func (s *CookieFlashStore) Load(c *fiber.Ctx) (string, error) {
+	if len(s.Secret) == 0 {
+		return "", ErrFlashSecret
+	}
+
+	v := c.Cookies(s.Name)
+	if v == "" {
+		return "", nil
+	}
+
+	c.Cookie(s.cookie("", time.Unix(0, 0)))
+
+	payload, sig, ok := strings.Cut(v, ".")
+	if !ok {
+		return "", ErrFlashSignature
+	}
+
+	mac, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(mac, s.sign(payload)) {
+		return "", ErrFlashSignature
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// Save saves the flash for the next request.
+// ErrFlashTooLarge is returned if the signed flash exceeds MaxFlashCookieSize.
+func (s *CookieFlashStore) Save(c *fiber.Ctx, flash string) error {
+	if len(s.Secret) == 0 {
+		return ErrFlashSecret
+	}
+
+	payload := base64.RawURLEncoding.EncodeToString([]byte(flash))
+	value := payload + "." + base64.RawURLEncoding.EncodeToString(s.sign(payload))
+
+	if len(value) > MaxFlashCookieSize {
+		return fmt.Errorf("%w: %d bytes", ErrFlashTooLarge, len(value))
+	}
+
+	c.Cookie(s.cookie(value, time.Time{}))
+
+	return nil
+}
+
+func (s *CookieFlashStore) sign(payload string) []byte {
+	h := hmac.New(sha256.New, s.Secret)
+	h.Write([]byte(payload))
+
+	return h.Sum(nil)
+}
+
+func (s *CookieFlashStore) cookie(value string, expires time.Time) *fiber.Cookie {
+	return &fiber.Cookie{
+		Name:     s.Name,
+		Value:    value,
+		Path:     s.Path,
+		Expires:  expires,
+		Secure:   s.Secure,
+		HTTPOnly: true,
+		SameSite: fiber.CookieSameSiteLaxMode,
+	}
+}
+
+var _ FlashStore = (*MemoryFlashStore)(nil)
+
+// MemoryFlashStore keeps the flash in the memory of the process for the goth session of the request.
+// Requests without a session are not flashed. Flashes are lost on restart and are not shared
+// between instances of an application, flashes that are not read are removed after the TTL.
+type MemoryFlashStore struct {
+	// TTL is the time an unread flash is kept.
+	TTL time.Duration
+
+	mu      sync.Mutex
+	flashes map[string]memoryFlash
+	swept   time.Time
+}
+
+type memoryFlash struct {
+	value   string
+	expires time.Time
+}
+
+// NewMemoryFlashStore returns a new memory store for the flash that keeps unread flashes for five minutes.
+func NewMemoryFlashStore() *MemoryFlashStore {
+	return &MemoryFlashStore{
+		TTL: 5 * time.Minute,
+	}
+}
+
+// Load returns the flash of the previous request and clears it.
+func (s *MemoryFlashStore) Load(c *fiber.Ctx) (string, error) {
+	session, err := goth.SessionFromContext(c)
+	if err != nil {
+		return "", nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, ok := s.flashes[session.ID.String()]
+	if !ok {
+		return "", nil
+	}
+
+	delete(s.flashes, session.ID.String())
+
+	if time.Now().After(f.expires) {
+		return "", nil
+	}
+
+	return f.value, nil
+}
+
+// Save saves the flash for the next request and removes expired flashes.
+func (s *MemoryFlashStore) Save(c *fiber.Ctx, flash string) error {
+	session, err := goth.SessionFromContext(c)
+	if err != nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+
+	if s.flashes == nil {
+		s.flashes = make(map[string]memoryFlash)
+	}
+
+	if now.Sub(s.swept) > s.TTL {
+		for id, f := range s.flashes {
+			if now.After(f.expires) {
+				delete(s.flashes, id)
+			}
+		}
+
+		s.swept = now
+	}
+
+	s.flashes[session.ID.String()] = memoryFlash{value: flash, expires: now.Add(s.TTL)}
+
+	return nil
+}
+
+// Len returns the number of flashes that are kept.
+func (s *MemoryFlashStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.flashes)
+}
+
+// FlashConfig is the configuration of the flash handler.
+type FlashConfig struct {
+	// Next defines a function to skip this middleware when returned true.
+	Next func(c *fiber.Ctx) bool
+	// Store keeps the flash for the next request.
+	//
+	// Required.
+	Store FlashStore
+	// Events are the names of the events that are kept for the next request,
+	// other events are triggered only for the request.
+	//
+	// Optional. Default: messages and htmx-toasts:notify
+	Events []string
+}
+
+// FlashConfigDefault is the default config of the flash handler.
+var FlashConfigDefault = FlashConfig{
+	Events: []string{"messages", "htmx-toasts:notify"},
+}
+
+// NewFlashHandler returns a new middleware that keeps the events and the messages of a request
+// for the next request if the response is a redirect. The events of the previous request are
+// added to the triggers of the request, so htmx requests receive them with the HX-Trigger header
+// and full page loads can render them e.g. with toasts.Toasts.
+// It should be registered before NewHtmxMessageHandler.
+//
+//	app.Use(htmx.NewFlashHandler(htmx.FlashConfig{Store: htmx.NewCookieFlashStore(secret)}))
+//
+// It panics if the config has no store.
+func NewFlashHandler(config ...FlashConfig) fiber.Handler {
+	cfg := flashConfigDefault(config...)
+
+	if cfg.Store == nil {
+		panic("htmx: flash handler requires a store")
+	}
+
+	return func(c *fiber.Ctx) (err error) {
+		if cfg.Next != nil && cfg.Next(c) {
+			return c.Next()
+		}
+
+		flash, err := cfg.Store.Load(c)
+		if errors.Is(err, ErrFlashSignature) {
+			flash, err = "", nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		triggers := TriggersFromContext(c)
+
+		err = triggers.Parse(HXTrigger, flash)
+		if err != nil {
+			return err
+		}
+
+		if Request(c) {
+			err = triggers.Write(c)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = c.Next()
+		if err != nil || !redirected(c) {
+			return err
+		}
+
+		if msgs := MessagesFromContext(c); msgs != nil && !slices.Contains(triggers.Details(HXTrigger, "messages"), any(msgs)) {
+			triggers.Trigger("messages", msgs)
+		}
+
+		flash, err = triggers.Flash(cfg.Events...)
+		if err != nil || flash == "" {
+			return err
+		}
+
+		c.Response().Header.Del(HXTrigger.String())
+
+		return cfg.Store.Save(c, flash)
+	}
+}
+
+// redirected returns true if the response redirects the client.
+func redirected(c *fiber.Ctx) bool {
+	status := c.Response().StatusCode()
+	if status >= fiber.StatusMultipleChoices && status < fiber.StatusBadRequest {
+		return true
+	}
+
+	for _, h := range []HxResponseHeader{HXRedirect, HXLocation, HXRefresh} {
+		if c.GetRespHeader(h.String()) != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Details returns the details of the event of the given header.
+func (t *Triggers) Details(header HxResponseHeader, name string) []any {
+	for _, e := range t.events[header] {
+		if e.name == name {
+			return e.details
+		}
+	}
+
+	return nil
+}
+
+// messages sets the messages as the details of the messages event.
+// Flashed messages of the previous request are added to the messages.
+func (t *Triggers) messages(msgs *HtmxMessages) error {
+	for _, e := range t.events[HXTrigger] {
+		if e.name != "messages" {
+			continue
+		}
+
+		for _, d := range e.details {
+			b, err := json.Marshal(d)
+			if err != nil {
+				return err
+			}
+
+			var flashed HtmxMessages
+			if err := json.Unmarshal(b, &flashed); err != nil {
+				return err
+			}
+
+			msgs.Add(flashed...)
+		}
+
+		e.details = []any{msgs}
+
+		return nil
+	}
+
+	t.Trigger("messages", msgs)
+
+	return nil
+}
+
+// Flash returns the value of the HX-Trigger header with the given events
+// without the events that have only empty details.
+func (t *Triggers) Flash(events ...string) (string, error) {
+	flash := NewTriggers()
+
+	for _, e := range t.events[HXTrigger] {
+		if !slices.Contains(events, e.name) {
+			continue
+		}
+
+		details := []any{}
+
+		for _, d := range e.details {
+			b, err := json.Marshal(d)
+			if err != nil {
+				return "", err
+			}
+
+			if s := string(b); s != "null" && s != "[]" && s != "{}" {
+				details = append(details, d)
+			}
+		}
+
+		if len(e.details) > 0 && len(details) == 0 {
+			continue
+		}
+
+		flash.Trigger(e.name, details...)
+	}
+
+	return flash.Header(HXTrigger)
+}
+
+// FromCtx renders the node that is returned by the function for the request that is rendered.
+// The request is nil if the node is not rendered into a request.
+func FromCtx(fn func(c *fiber.Ctx) Node) Node {
+	return NodeFunc(func(w io.Writer) error {
+		n := fn(ctxFromWriter(w))
+		if n == nil {
+			return nil
+		}
+
+		return n.Render(w)
+	})
+}
+
+// Helper function to set default values
+func flashConfigDefault(config ...FlashConfig) FlashConfig {
+	if len(config) < 1 {
+		return FlashConfigDefault
+	}
+
+	// Override default config
+	cfg := config[0]
+
+	if cfg.Events == nil {
+		cfg.Events = FlashConfigDefault.Events
+	}
+
+	return cfg
+}
@@ -0,0 +1,365 @@
+package htmx_test
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	goth "github.com/zeiss/fiber-goth"
+	"github.com/zeiss/fiber-goth/adapters"
+	htmx "github.com/zeiss/fiber-htmx"
+	"github.com/zeiss/fiber-htmx/components/toasts"
+)
+
+var flashSecret = []byte("secret")
+
+func newFlashApp() *fiber.App {
+	app := fiber.New()
+	app.Use(htmx.NewFlashHandler(htmx.FlashConfig{Store: htmx.NewCookieFlashStore(flashSecret)}))
+	app.Use(htmx.NewHtmxMessageHandler())
+
+	app.Post("/projects", func(c *fiber.Ctx) error {
+		htmx.MessagesFromContext(c).Add(htmx.HtmxMessage{Message: "Project created", Tags: "success"})
+
+		return c.Redirect("/projects", fiber.StatusSeeOther)
+	})
+
+	app.Post("/empty", func(c *fiber.Ctx) error {
+		return c.Redirect("/projects", fiber.StatusSeeOther)
+	})
+
+	app.Post("/toasts", func(c *fiber.Ctx) error {
+		toasts.Success(c, "Project created")
+		toasts.Success(c, "Member invited")
+
+		if err := htmx.TriggerEvent(c, "reload"); err != nil {
+			return err
+		}
+
+		return c.Redirect("/notifications", fiber.StatusSeeOther)
+	})
+
+	app.Get("/notifications", htmx.NewCompHandler(htmx.FromCtx(func(c *fiber.Ctx) htmx.Node {
+		return htmx.Text(fmt.Sprint(toasts.Notifications(c)))
+	})))
+
+	app.Get("/projects", htmx.NewCompHandler(htmx.FromCtx(func(c *fiber.Ctx) htmx.Node {
+		return htmx.Text(fmt.Sprint(*htmx.MessagesFromContext(c)))
+	})))
+
+	return app
+}
+
+func flashCookie(t *testing.T, resp *http.Response) *http.Cookie {
+	t.Helper()
+
+	for _, c := range resp.Cookies() {
+		if c.Name == "htmx-flash" {
+			return c
+		}
+	}
+
+	return nil
+}
+
+func TestFlashHandler(t *testing.T) {
+	t.Parallel()
+
+	app := newFlashApp()
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodPost, "/projects", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusSeeOther, resp.StatusCode)
+	assert.Empty(t, resp.Header.Get("HX-Trigger"))
+
+	cookie := flashCookie(t, resp)
+	require.NotNil(t, cookie)
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		trigger string
+		body    string
+	}{
+		{
+			name:    "htmx request",
+			headers: map[string]string{"HX-Request": "true"},
+			trigger: `{"messages":[{"message":"Project created","tags":"success"}]}`,
+		},
+		{
+			name: "full page",
+			body: "[{Project created success}]",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(fiber.MethodGet, "/projects", nil)
+			req.AddCookie(cookie)
+
+			for k, v := range test.headers {
+				req.Header.Set(k, v)
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.trigger, resp.Header.Get("HX-Trigger"))
+
+			if test.body != "" {
+				assert.Equal(t, test.body, string(body))
+			}
+
+			cleared := flashCookie(t, resp)
+			require.NotNil(t, cleared)
+			assert.Empty(t, cleared.Value)
+		})
+	}
+}
+
+func TestFlashHandlerEmpty(t *testing.T) {
+	t.Parallel()
+
+	app := newFlashApp()
+
+	req := httptest.NewRequest(fiber.MethodPost, "/empty", nil)
+	req.Header.Set("HX-Request", "true")
+
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	assert.Nil(t, flashCookie(t, resp))
+}
+
+func TestFlashHandlerTampered(t *testing.T) {
+	t.Parallel()
+
+	app := newFlashApp()
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodPost, "/projects", nil))
+	require.NoError(t, err)
+
+	cookie := flashCookie(t, resp)
+	require.NotNil(t, cookie)
+
+	payload, sig, ok := strings.Cut(cookie.Value, ".")
+	require.True(t, ok)
+
+	forged := base64.RawURLEncoding.EncodeToString([]byte(`{"evil":"x"}`))
+
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{name: "forged payload", value: forged + "." + sig},
+		{name: "invalid signature", value: payload + ".AAAA"},
+		{name: "unsigned", value: payload},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(fiber.MethodGet, "/projects", nil)
+			req.Header.Set("HX-Request", "true")
+			req.AddCookie(&http.Cookie{Name: "htmx-flash", Value: test.value})
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+			assert.Equal(t, `{"messages":[]}`, resp.Header.Get("HX-Trigger"))
+
+			cleared := flashCookie(t, resp)
+			require.NotNil(t, cleared)
+			assert.Empty(t, cleared.Value)
+		})
+	}
+}
+
+func TestCookieFlashStoreTooLarge(t *testing.T) {
+	t.Parallel()
+
+	store := htmx.NewCookieFlashStore(flashSecret)
+
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		return store.Save(c, strings.Repeat("x", htmx.MaxFlashCookieSize))
+	})
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusInternalServerError, resp.StatusCode)
+	assert.Nil(t, flashCookie(t, resp))
+}
+
+type flashSessionAdapter struct {
+	adapters.UnimplementedAdapter
+	id uuid.UUID
+}
+
+func (a *flashSessionAdapter) GetSession(_ context.Context, _ string) (adapters.GothSession, error) {
+	return adapters.GothSession{ID: a.id, ExpiresAt: time.Now().Add(time.Hour)}, nil
+}
+
+func (a *flashSessionAdapter) RefreshSession(_ context.Context, session adapters.GothSession) (adapters.GothSession, error) {
+	return session, nil
+}
+
+func TestMemoryFlashStore(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		ttl   time.Duration
+		sleep time.Duration
+		want  string
+	}{
+		{
+			name: "read",
+			ttl:  time.Minute,
+			want: "flash",
+		},
+		{
+			name:  "expired",
+			ttl:   time.Millisecond,
+			sleep: 10 * time.Millisecond,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := htmx.NewMemoryFlashStore()
+			store.TTL = test.ttl
+
+			var got string
+
+			app := fiber.New()
+			app.Use(func(c *fiber.Ctx) error {
+				return goth.NewProtectedHandler(func(c *fiber.Ctx) error { return c.Next() }, goth.Config{
+					Adapter: &flashSessionAdapter{id: uuid.MustParse(c.Query("session"))},
+					Extractor: func(_ *fiber.Ctx) (string, error) {
+						return "token", nil
+					},
+				})(c)
+			})
+			app.Post("/", func(c *fiber.Ctx) error {
+				return store.Save(c, "flash")
+			})
+			app.Get("/", func(c *fiber.Ctx) (err error) {
+				got, err = store.Load(c)
+				return err
+			})
+
+			session := uuid.New().String()
+
+			_, err := app.Test(httptest.NewRequest(fiber.MethodPost, "/?session="+session, nil))
+			require.NoError(t, err)
+			assert.Equal(t, 1, store.Len())
+
+			time.Sleep(test.sleep)
+
+			// a flash for another session sweeps the expired flashes
+			_, err = app.Test(httptest.NewRequest(fiber.MethodPost, "/?session="+uuid.New().String(), nil))
+			require.NoError(t, err)
+
+			_, err = app.Test(httptest.NewRequest(fiber.MethodGet, "/?session="+session, nil))
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, 1, store.Len())
+		})
+	}
+}
+
+func TestFlashHandlerToasts(t *testing.T) {
+	t.Parallel()
+
+	resp, err := newFlashApp().Test(httptest.NewRequest(fiber.MethodPost, "/toasts", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusSeeOther, resp.StatusCode)
+
+	cookie := flashCookie(t, resp)
+	require.NotNil(t, cookie)
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		trigger string
+		body    string
+	}{
+		{
+			name:    "htmx request",
+			headers: map[string]string{"HX-Request": "true"},
+			trigger: `{"htmx-toasts:notify":{"items":[{"code":200,"level":"success","message":"Project created"},{"code":200,"level":"success","message":"Member invited"}]},"messages":[]}`,
+		},
+		{
+			name: "full page",
+			body: "[Project created Member invited]",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// a new app with the same secret reads the flash e.g. after a restart
+			req := httptest.NewRequest(fiber.MethodGet, "/notifications", nil)
+			req.AddCookie(cookie)
+
+			for k, v := range test.headers {
+				req.Header.Set(k, v)
+			}
+
+			resp, err := newFlashApp().Test(req)
+			require.NoError(t, err)
+			assert.Equal(t, test.trigger, resp.Header.Get("HX-Trigger"))
+
+			if test.body != "" {
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				assert.Equal(t, test.body, string(body))
+			}
+		})
+	}
+}
+
+func TestNewFlashHandlerWithoutStore(t *testing.T) {
+	t.Parallel()
+
+	assert.Panics(t, func() {
+		htmx.NewFlashHandler()
+	})
+}
+
+func TestCookieFlashStoreWithoutSecret(t *testing.T) {
+	t.Parallel()
+
+	store := htmx.NewCookieFlashStore(nil)
+
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		return store.Save(c, "flash")
+	})
+	app.Get("/load", func(c *fiber.Ctx) error {
+		_, err := store.Load(c)
+		return err
+	})
+
+	for _, path := range []string{"/", "/load"} {
+		resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, path, nil))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusInternalServerError, resp.StatusCode)
+		assert.Nil(t, flashCookie(t, resp))
+	}
+}
@@ -4,3 +4,4 @@
 package htmx
 
 //go:generate npx tailwindcss -i ./src/input.css -o ./out/out.css
+//go:generate go run ./cmd/html
@@ -8,11 +8,12 @@ import (
 
 // HTML5Props represents the properties for an HTML5 document.
 type HTML5Props struct {
-	Title       string // The title of the HTML document.
-	Description string // The description of the HTML document.
-	Language    string // The language of the HTML document.
-	Head        []Node // The nodes to be included in the head section of the HTML document.
-	Attributes  []Node // The attributes to be included in the HTML document.
+	Title       string      // The title of the HTML document.
+	Description string      // The description of the HTML document.
+	Language    string      // The language of the HTML document.
+	Head        []Node      // The nodes to be included in the head section of the HTML document.
+	Attributes  []Node      // The attributes to be included in the HTML document.
+	HtmxConfig  *HtmxConfig // The runtime configuration of htmx for the HTML document.
 }
 
 // HTML5 generates an HTML5 document based on the provided properties.
@@ -26,6 +27,7 @@ func HTML5(props HTML5Props, body ...Node) Node {
 				Meta(Name("viewport"), Content("width=device-width, initial-scale=1")),
 				TitleElement(Text(props.Title)),
 				If(props.Description != "", Meta(Name("description"), Content(props.Description))),
+				If(props.HtmxConfig != nil, props.HtmxConfig),
 				Group(props.Head...),
 			),
 			Body(body...),
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	htmx "github.com/zeiss/fiber-htmx"
+	"github.com/zeiss/pkg/cast"
 )
 
 func Benchmark_HTML5_Render(b *testing.B) {
@@ -99,3 +100,21 @@ func Test_ClassNames_Render(t *testing.T) {
 		})
 	}
 }
+
+func Test_HTML5_HtmxConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg := htmx.NewHtmxConfig()
+	cfg.DefaultSwapStyle = htmx.HxSwapOuterHTML
+	cfg.SelfRequestsOnly = cast.Ptr(false)
+	cfg.ResponseHandling = []htmx.HtmxResponseHandling{
+		{Code: "422", Swap: true},
+		{Code: "[45]..", Swap: false, Error: cast.Ptr(true)},
+	}
+
+	var buf bytes.Buffer
+	err := htmx.HTML5(htmx.HTML5Props{Title: "config", HtmxConfig: cfg}).Render(&buf)
+	require.NoError(t, err)
+
+	assert.Contains(t, buf.String(), `<meta name="htmx-config" content="{&#34;defaultSwapStyle&#34;:&#34;outerHTML&#34;,&#34;defaultSwapDelay&#34;:0,&#34;defaultSettleDelay&#34;:20,&#34;selfRequestsOnly&#34;:false,&#34;responseHandling&#34;:[{&#34;code&#34;:&#34;422&#34;,&#34;swap&#34;:true},{&#34;code&#34;:&#34;[45]..&#34;,&#34;swap&#34;:false,&#34;error&#34;:true}]}">`)
+}
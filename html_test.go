@@ -127,3 +127,27 @@ func ExampleElement_div() {
 	_ = Element("div").Render(os.Stdout)
 	// Output: <div></div>
 }
+
+func TestElementSearch(t *testing.T) {
+	t.Parallel()
+
+	e := Search(Menu(Li(Output(For("a b")))))
+	assert.NotNil(t, e)
+	assert.Equal(t, `<search><menu><li><output for="a b"></output></li></menu></search>`, e.(NodeFunc).String())
+}
+
+func TestElementMap(t *testing.T) {
+	t.Parallel()
+
+	e := MapElement(Name("map"), Area(Shape(ShapeCircle), Coords("1,2,3")))
+	assert.NotNil(t, e)
+	assert.Equal(t, `<map name="map"><area shape="circle" coords="1,2,3"></map>`, e.(NodeFunc).String())
+}
+
+func TestElementHgroup(t *testing.T) {
+	t.Parallel()
+
+	e := Hgroup(H1(Text("Title")))
+	assert.Equal(t, `<hgroup><h1>Title</h1></hgroup>`, e.(NodeFunc).String())
+	assert.Equal(t, e.(NodeFunc).String(), HGroup(H1(Text("Title"))).(NodeFunc).String())
+}
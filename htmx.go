@@ -1,7 +1,7 @@
 package htmx
 
 import (
-	"encoding/json"
+	"database/sql"
 	"errors"
 	"fmt"
 	"net/http"
@@ -9,6 +9,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	authz "github.com/zeiss/fiber-authz"
 	"github.com/zeiss/pkg/conv"
+	"gorm.io/gorm"
 )
 
 // The contextKey type is unexported to prevent collisions with context keys defined in
@@ -18,6 +19,11 @@ type contextKey int
 // The keys for the values in context
 const (
 	messagesKey contextKey = iota
+	triggersKey
+	requestKey
+	txKey
+	authzKey
+	containerKey
 )
 
 const (
@@ -107,8 +113,16 @@ func ReSelect(c *fiber.Ctx, target string) {
 }
 
 // HxTriggers is a helper function to trigger an event.
+// The target is either a JSON object or a comma separated list of event names
+// and is merged with the other events of the request.
 func HxTriggers(c *fiber.Ctx, target string) {
-	c.Set(HXTrigger.String(), target)
+	t := TriggersFromContext(c)
+	if err := t.Parse(HXTrigger, target); err != nil {
+		c.Set(HXTrigger.String(), target)
+		return
+	}
+
+	_ = t.Write(c)
 }
 
 // Boosted returns true if the request is boosted.
@@ -146,9 +160,14 @@ func TriggerName(c *fiber.Ctx) string {
 	return c.Get(HxRequestHeaderTriggerName.String())
 }
 
+// TriggerID is a helper function to get the id of the triggered element.
+func TriggerID(c *fiber.Ctx) string {
+	return c.Get(HxRequestHeaderTrigger.String())
+}
+
 // Trigger is a helper function to trigger an event.
 func Trigger(c *fiber.Ctx, target string) {
-	c.Set(HxRequestHeaderTrigger.String(), target)
+	HxTriggers(c, target)
 }
 
 // RenderPartial returns true if the request is an htmx request.
@@ -190,12 +209,34 @@ type Config struct {
 	Next func(c *fiber.Ctx) bool
 	// Filters is a list of filters that filter the context.
 	Filters []FilterFunc
+	// Chain is the ordered list of before and after filters of the controllers.
+	// Before filters run after Filters, after filters run after the controller also if it fails.
+	//
+	// Optional. Default: nil
+	Chain []ControllerFilter
 	// AuthzChecker is a function that authenticates the user.
 	AuthzChecker authz.AuthzChecker
 	// ErrorHandler is executed when an error is returned from fiber.Handler.
 	//
 	// Optional. Default: DefaultErrorHandler
 	ErrorHandler fiber.ErrorHandler
+	// ETag sets a weak ETag on the rendered component and defines how a matching If-None-Match is answered.
+	//
+	// Optional. Default: ETagDisabled
+	ETag ETagMode
+	// DB is the database that is used for the transactions of a TxController.
+	//
+	// Optional. Default: nil
+	DB *gorm.DB
+	// SavePoints nests the transaction of a TxController into a savepoint
+	// if a transaction is already in progress for the request.
+	//
+	// Optional. Default: false
+	SavePoints bool
+	// Container builds the controllers of Inject and injects the tagged fields of controllers.
+	//
+	// Optional. Default: nil
+	Container *Container
 }
 
 // ConfigDefault is the default config.
@@ -208,9 +249,11 @@ var ConfigDefault = Config{
 	AuthzChecker: authz.NewNoop(),
 }
 
-// default ErrorHandler that process return error from fiber.Handler
-func defaultErrorHandler(_ *fiber.Ctx, _ error) error {
-	return fiber.ErrBadRequest
+// default ErrorHandler that keeps the status code and the message for the user of the error
+func defaultErrorHandler(_ *fiber.Ctx, err error) error {
+	e := AsHTTPError(err)
+
+	return fiber.NewError(e.Code, e.Message)
 }
 
 // RenderOpt is helper function to configure the render.
@@ -240,7 +283,7 @@ func NewCompHandler(n Node, config ...Config) fiber.Handler {
 
 		c.Set(fiber.HeaderContentType, fiber.MIMETextHTML)
 
-		err := n.Render(c)
+		err := renderETag(c, cfg.ETag, n)
 		if err != nil {
 			return cfg.ErrorHandler(c, err)
 		}
@@ -268,7 +311,7 @@ func NewCompFuncHandler(handler CompFunc, config ...Config) fiber.Handler {
 			return cfg.ErrorHandler(c, err)
 		}
 
-		err = n.Render(c)
+		err = renderETag(c, cfg.ETag, n)
 		if err != nil {
 			return cfg.ErrorHandler(c, err)
 		}
@@ -284,108 +327,306 @@ func NewHxControllerHandler(ctrl ControllerFactory, config ...Config) fiber.Hand
 }
 
 // NewControllerHandler returns a new htmx controller handler.
-// nolint:gocyclo
 func NewControllerHandler(factory ControllerFactory, config ...Config) fiber.Handler {
 	cfg := configDefault(config...)
 
-	return func(c *fiber.Ctx) (err error) {
+	return func(c *fiber.Ctx) error {
 		if cfg.Next != nil && cfg.Next(c) {
 			return c.Next()
 		}
 
-		ctrl := factory()
+		return serveController(c, factory(), cfg, serveMethod)
+	}
+}
 
-		// Initialize the controller
-		err = ctrl.Init(c)
-		if err != nil {
-			return cfg.ErrorHandler(c, err)
+// MaxPooledControllers is the maximum number of idle controllers of a pooled controller handler.
+const MaxPooledControllers = 256
+
+// NewPooledControllerHandler returns a new htmx controller handler that reuses controllers from a pool.
+// The controller is reset after Finalize or Error and is then returned to the pool,
+// up to MaxPooledControllers idle controllers are kept.
+//
+// Controllers must clear all request state in Reset. Only a controller whose Ctx returns nil
+// after Reset is returned to the pool, this is the only guard against a controller that is
+// used after its request, other controllers are discarded. A controller must not be used after
+// its handler returns, e.g. by a goroutine that it started, as it then serves another request.
+// Fields that are tagged with inject are set again for each request.
+//
+// It panics if the factory is Inject, as the container builds a new controller for each request.
+func NewPooledControllerHandler(factory ControllerFactory, config ...Config) fiber.Handler {
+	cfg := configDefault(config...)
+
+	ctrl := factory()
+	if _, ok := ctrl.(injectedController); ok {
+		panic("htmx: Inject can not be used with a pooled controller handler")
+	}
+
+	pool := make(chan Controller, MaxPooledControllers)
+	pool <- ctrl
+
+	return func(c *fiber.Ctx) error {
+		if cfg.Next != nil && cfg.Next(c) {
+			return c.Next()
+		}
+
+		var ctrl Controller
+
+		select {
+		case ctrl = <-pool:
+		default:
+			ctrl = factory()
 		}
 
-		// Recover from panic if controller is initialized
 		defer func() {
-			if r := recover(); r != nil {
-				var ok bool
-				err, ok = r.(error)
-				if !ok {
-					err = fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("%v", r))
-				}
-				err = ctrl.Error(err)
+			ctrl.Reset()
+
+			if ctrl.Ctx() != nil {
+				return
+			}
+
+			select {
+			case pool <- ctrl:
+			default:
 			}
 		}()
 
-		c.Set(fiber.HeaderContentType, fiber.MIMETextHTML)
+		return serveController(c, ctrl, cfg, serveMethod)
+	}
+}
 
-		auth, ok := ctrl.(authz.AuthzController) // check for authz from the controller
-		if ok && cfg.AuthzChecker != nil {
-			principal, err := auth.GetPrincipial(c)
-			if err != nil {
-				return ctrl.Error(err)
-			}
+// txScope is the transaction of a controller for a single request.
+type txScope struct {
+	c         *fiber.Ctx
+	tc        TxController
+	outer     *gorm.DB
+	committed bool
+}
 
-			object, err := auth.GetObject(c)
-			if err != nil {
-				return ctrl.Error(err)
-			}
+// beginTx begins the transaction of the controller before Prepare.
+// Requests with GET or HEAD use a read-only transaction.
+func beginTx(c *fiber.Ctx, tc TxController, cfg Config) (*txScope, error) {
+	conn := cfg.DB.WithContext(c.UserContext())
 
-			action, err := auth.GetAction(c)
-			if err != nil {
-				return ctrl.Error(err)
-			}
+	outer := TxFromContext(c)
+	if outer != nil && cfg.SavePoints {
+		conn = outer
+	}
 
-			allowed, err := cfg.AuthzChecker.Allowed(c.Context(), principal, object, action)
-			if err != nil {
-				return ctrl.Error(err)
-			}
+	var opts []*sql.TxOptions
+	if c.Method() == fiber.MethodGet || c.Method() == fiber.MethodHead {
+		opts = append(opts, &sql.TxOptions{ReadOnly: true})
+	}
+
+	err := tc.BeginTx(conn, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Locals(txKey, tc.Tx())
+
+	return &txScope{c: c, tc: tc, outer: outer}, nil
+}
+
+// commit commits the transaction after a successful Finalize.
+func (s *txScope) commit() error {
+	err := s.tc.Commit()
+	if err != nil {
+		return err
+	}
+
+	s.committed = true
+
+	return nil
+}
+
+// end rolls back the transaction if it was not committed, e.g. on error or panic.
+func (s *txScope) end() {
+	s.c.Locals(txKey, s.outer)
+
+	if !s.committed {
+		_ = s.tc.Rollback()
+	}
+}
+
+// TxFromContext returns the transaction of the request, nil if no transaction is in progress.
+func TxFromContext(c *fiber.Ctx) *gorm.DB {
+	tx, ok := c.Locals(txKey).(*gorm.DB)
+	if !ok {
+		return nil
+	}
+
+	return tx
+}
+
+// actionFunc calls the handler of the controller for the request.
+type actionFunc func(c *fiber.Ctx, ctrl Controller) error
+
+// serveController runs the lifecycle and the after filters of the controller for the request
+// and passes the returned error to the error handler.
+func serveController(c *fiber.Ctx, ctrl Controller, cfg Config, action actionFunc) error {
+	ctrl, err := buildController(c, ctrl, cfg)
+	if err != nil {
+		return cfg.ErrorHandler(c, err)
+	}
+
+	chain := newFilterChain(c, ctrl, cfg)
+
+	err = runController(c, ctrl, cfg, chain, action)
+
+	err = chain.after(c, err)
+	if err != nil {
+		return cfg.ErrorHandler(c, err)
+	}
+
+	return nil
+}
+
+// runController runs the lifecycle of the controller for the request.
+// nolint:gocyclo
+func runController(c *fiber.Ctx, ctrl Controller, cfg Config, chain filterChain, action actionFunc) (err error) {
+	var initialized bool
+
+	// Recover from panic, the controller handles the error once it is initialized
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoverError(r)
 
-			if !allowed {
-				return ctrl.Error(authz.ErrForbidden)
+			if initialized {
+				err = ctrl.Error(err)
 			}
 		}
+	}()
 
-		for _, f := range cfg.Filters {
-			err = f(c)
-			if err != nil {
-				return ctrl.Error(err)
-			}
+	// Initialize the controller
+	err = ctrl.Init(c)
+	if err != nil {
+		return err
+	}
+
+	initialized = true
+
+	c.Set(fiber.HeaderContentType, fiber.MIMETextHTML)
+
+	auth, ok := ctrl.(authz.AuthzController) // check for authz from the controller
+	if ok && cfg.AuthzChecker != nil {
+		principal, err := auth.GetPrincipial(c)
+		if err != nil {
+			return ctrl.Error(err)
 		}
 
-		err = ctrl.Prepare()
+		object, err := auth.GetObject(c)
 		if err != nil {
 			return ctrl.Error(err)
 		}
 
-		switch c.Method() {
-		case fiber.MethodGet:
-			err = ctrl.Get()
-		case fiber.MethodPost:
-			err = ctrl.Post()
-		case fiber.MethodPut:
-			err = ctrl.Put()
-		case fiber.MethodPatch:
-			err = ctrl.Patch()
-		case fiber.MethodDelete:
-			err = ctrl.Delete()
-		case fiber.MethodOptions:
-			err = ctrl.Options()
-		case fiber.MethodTrace:
-			err = ctrl.Trace()
-		case fiber.MethodHead:
-			err = ctrl.Head()
-		default:
-			err = fiber.ErrMethodNotAllowed
+		action, err := auth.GetAction(c)
+		if err != nil {
+			return ctrl.Error(err)
 		}
 
+		allowed, err := cfg.AuthzChecker.Allowed(c.Context(), principal, object, action)
 		if err != nil {
 			return ctrl.Error(err)
 		}
 
-		err = ctrl.Finalize()
+		if !allowed {
+			return ctrl.Error(authz.ErrForbidden)
+		}
+
+		WithAuthz(c, cfg.AuthzChecker, principal)
+	}
+
+	if b, ok := ctrl.(BindingController); ok {
+		err = b.Bind()
 		if err != nil {
 			return ctrl.Error(err)
 		}
+	}
 
-		return nil
+	for _, f := range cfg.Filters {
+		err = f(c)
+		if err != nil {
+			return ctrl.Error(err)
+		}
+	}
+
+	n, err := chain.before(c)
+	if err != nil {
+		return ctrl.Error(err)
+	}
+
+	if n != nil {
+		return n.Render(c)
+	}
+
+	var tx *txScope
+
+	tc, ok := ctrl.(TxController)
+	if ok && cfg.DB != nil {
+		tx, err = beginTx(c, tc, cfg)
+		if err != nil {
+			return ctrl.Error(err)
+		}
+		defer tx.end()
+	}
+
+	err = ctrl.Prepare()
+	if err != nil {
+		return ctrl.Error(err)
+	}
+
+	v, ok := ctrl.(ValidationController)
+
+	switch {
+	case ok && !v.Valid():
+		err = v.Invalid()
+	default:
+		err = action(c, ctrl)
+	}
+
+	if err != nil {
+		return ctrl.Error(err)
+	}
+
+	err = ctrl.Finalize()
+	if err != nil {
+		return ctrl.Error(err)
+	}
+
+	if tx != nil {
+		err = tx.commit()
+		if err != nil {
+			return ctrl.Error(err)
+		}
 	}
+
+	return nil
+}
+
+// serveMethod calls the method handler of the controller for the request method.
+func serveMethod(c *fiber.Ctx, ctrl Controller) (err error) {
+	switch c.Method() {
+	case fiber.MethodGet:
+		err = ctrl.Get()
+	case fiber.MethodPost:
+		err = ctrl.Post()
+	case fiber.MethodPut:
+		err = ctrl.Put()
+	case fiber.MethodPatch:
+		err = ctrl.Patch()
+	case fiber.MethodDelete:
+		err = ctrl.Delete()
+	case fiber.MethodOptions:
+		err = ctrl.Options()
+	case fiber.MethodTrace:
+		err = ctrl.Trace()
+	case fiber.MethodHead:
+		err = ctrl.Head()
+	default:
+		err = fiber.ErrMethodNotAllowed
+	}
+
+	return err
 }
 
 // NewHtmxMessageHandler is a helper function to handle htmx messages.
@@ -400,25 +641,25 @@ func NewHtmxMessageHandler(config ...Config) fiber.Handler {
 		header := NewHtmxMessageHeader()
 		c.Locals(messagesKey, header.Messages)
 
+		triggers := TriggersFromContext(c)
+
+		err = triggers.messages(header.Messages)
+		if err != nil {
+			return err
+		}
+
 		if Request(c) {
-			defer func() { err = addHeaders(c, header) }()
+			defer func() {
+				if e := triggers.Write(c); err == nil {
+					err = e
+				}
+			}()
 		}
 
 		return c.Next()
 	}
 }
 
-func addHeaders(c *fiber.Ctx, headers *HtmxMessageHeader) error {
-	b, err := json.Marshal(headers)
-	if err != nil {
-		return err
-	}
-
-	c.Append(HXTrigger.String(), string(b))
-
-	return nil
-}
-
 // HtmxMessageHeader is a struct that represents a message header.
 type HtmxMessageHeader struct {
 	// Message is the message for the user.
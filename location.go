@@ -0,0 +1,102 @@
+package htmx
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ErrLocationPath is returned when the path of a location is missing.
+var ErrLocationPath = errors.New("htmx: location path is required")
+
+// LocationOptions is the context object of the HX-Location response header.
+// See: https://htmx.org/headers/hx-location/
+type LocationOptions struct {
+	// Path is the url to load the response from.
+	Path string
+	// Source is the source element of the request.
+	Source string
+	// Event is an event that "triggered" the request.
+	Event string
+	// Handler is a callback that will handle the response HTML.
+	Handler string
+	// Target is the target to swap the response into.
+	Target string
+	// Swap is how the response will be swapped in relative to the target.
+	Swap *Swap
+	// Values are the values to submit with the request.
+	Values map[string]any
+	// Headers are the headers to submit with the request.
+	Headers map[string]string
+	// Select allows you to select the content you want swapped from a response.
+	Select string
+}
+
+type location struct {
+	Path    string            `json:"path"`
+	Source  string            `json:"source,omitempty"`
+	Event   string            `json:"event,omitempty"`
+	Handler string            `json:"handler,omitempty"`
+	Target  string            `json:"target,omitempty"`
+	Swap    string            `json:"swap,omitempty"`
+	Values  map[string]any    `json:"values,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Select  string            `json:"select,omitempty"`
+}
+
+// Validate validates the location options.
+func (l LocationOptions) Validate() error {
+	if l.Path == "" {
+		return ErrLocationPath
+	}
+
+	return nil
+}
+
+// MarshalJSON returns the JSON encoding of the location options.
+func (l LocationOptions) MarshalJSON() ([]byte, error) {
+	loc := location{
+		Path:    l.Path,
+		Source:  l.Source,
+		Event:   l.Event,
+		Handler: l.Handler,
+		Target:  l.Target,
+		Values:  l.Values,
+		Headers: l.Headers,
+		Select:  l.Select,
+	}
+
+	if l.Swap != nil {
+		loc.Swap = l.Swap.String()
+	}
+
+	return json.Marshal(loc)
+}
+
+func (l LocationOptions) pathOnly() bool {
+	return l.Source == "" && l.Event == "" && l.Handler == "" && l.Target == "" &&
+		l.Swap == nil && len(l.Values) == 0 && len(l.Headers) == 0 && l.Select == ""
+}
+
+// Location is a helper function to do a client-side redirect that does not do a full page reload.
+// A location with only a path is sent as the plain path.
+func Location(c *fiber.Ctx, opts LocationOptions) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+
+	if opts.pathOnly() {
+		c.Set(HXLocation.String(), opts.Path)
+		return nil
+	}
+
+	b, err := json.Marshal(opts)
+	if err != nil {
+		return err
+	}
+
+	c.Set(HXLocation.String(), string(b))
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package htmx_test
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	htmx "github.com/zeiss/fiber-htmx"
+)
+
+func TestLocation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		opts htmx.LocationOptions
+		want string
+		err  error
+	}{
+		{
+			name: "path",
+			opts: htmx.LocationOptions{Path: "/projects"},
+			want: "/projects",
+		},
+		{
+			name: "context",
+			opts: htmx.LocationOptions{
+				Path:    "/projects",
+				Target:  "#main",
+				Swap:    htmx.NewSwap().Style(htmx.HxSwapOuterHTML).Swap(time.Second),
+				Values:  map[string]any{"id": 1},
+				Headers: map[string]string{"X-Foo": "bar"},
+				Select:  "#content",
+			},
+			want: `{"path":"/projects","target":"#main","swap":"outerHTML swap:1s","values":{"id":1},"headers":{"X-Foo":"bar"},"select":"#content"}`,
+		},
+		{
+			name: "missing path",
+			opts: htmx.LocationOptions{Target: "#main"},
+			err:  htmx.ErrLocationPath,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Get("/", func(c *fiber.Ctx) error {
+				return htmx.Location(c, test.opts)
+			})
+
+			res, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+			require.NoError(t, err)
+
+			if test.err != nil {
+				assert.Equal(t, fiber.StatusInternalServerError, res.StatusCode)
+				return
+			}
+
+			assert.Equal(t, test.want, res.Header.Get(htmx.HXLocation.String()))
+		})
+	}
+}
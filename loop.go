@@ -1,5 +1,12 @@
 package htmx
 
+import (
+	"io"
+	"iter"
+	"sort"
+	"strings"
+)
+
 // RangeLoop is a loop control structure.
 type RangeLoop interface {
 	// Filter loops and filters the content.
@@ -10,14 +17,21 @@ type RangeLoop interface {
 	Group() Node
 }
 
+// rangeLoop keeps the indices of the selected source nodes and their current nodes.
 type rangeLoop struct {
+	idx   []int
 	nodes []Node
-	src   []Node
 }
 
 // Range loops over the content.
+// The functions of Filter and Map are called with the indices of the nodes in the content.
 func Range(nodes ...Node) RangeLoop {
-	return &rangeLoop{nodes: []Node{}, src: nodes}
+	idx := make([]int, len(nodes))
+	for i := range idx {
+		idx[i] = i
+	}
+
+	return &rangeLoop{idx: idx, nodes: nodes}
 }
 
 // Group returns the nodes as a group.
@@ -25,24 +39,30 @@ func (r *rangeLoop) Group() Node {
 	return Group(r.nodes...)
 }
 
-// Filter loops and slices the content.
+// Filter loops and keeps the content for which f returns true.
 func (r *rangeLoop) Filter(f func(int) bool) RangeLoop {
-	for i := range r.src {
+	idx := make([]int, 0, len(r.idx))
+	nodes := make([]Node, 0, len(r.nodes))
+
+	for j, i := range r.idx {
 		if f(i) {
-			r.nodes = append(r.nodes, r.src[i])
+			idx = append(idx, i)
+			nodes = append(nodes, r.nodes[j])
 		}
 	}
 
-	return r
+	return &rangeLoop{idx: idx, nodes: nodes}
 }
 
 // Map loops and maps the content.
 func (r *rangeLoop) Map(f func(int) Node) RangeLoop {
-	for i := range r.src {
-		r.nodes[i] = f(i)
+	nodes := make([]Node, 0, len(r.idx))
+
+	for _, i := range r.idx {
+		nodes = append(nodes, f(i))
 	}
 
-	return r
+	return &rangeLoop{idx: r.idx, nodes: nodes}
 }
 
 // Filter loops and filters the content.
@@ -58,6 +78,7 @@ func Filter(f func(n Node) bool, children ...Node) []Node {
 }
 
 // Map is using a map to transform into htmx nodes.
+// The order of the nodes is random, use EachSorted for a stable order.
 func Map[T1 comparable, T2 any](m map[T1]T2, f func(T1, T2) Node) Nodes {
 	nodes := make([]Node, 0, len(m))
 
@@ -92,3 +113,152 @@ func ForEach[S ~[]E, E comparable](s S, f func(E, int) Node) Nodes {
 
 	return nodes
 }
+
+// EachLoop is a loop that lazily renders the nodes of a sequence.
+type EachLoop struct {
+	seq      iter.Seq[Node]
+	sep      Node
+	fallback Node
+}
+
+// Each loops over the sequence and renders the node returned by the function for each item.
+// The items are rendered lazily, no intermediate slice of nodes is created.
+func Each[T any](seq iter.Seq[T], f func(T) Node) *EachLoop {
+	return &EachLoop{
+		seq: func(yield func(Node) bool) {
+			for v := range seq {
+				if !yield(f(v)) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// Each2 loops over the sequence of pairs and renders the node returned by the function for each pair.
+func Each2[K, V any](seq iter.Seq2[K, V], f func(K, V) Node) *EachLoop {
+	return &EachLoop{
+		seq: func(yield func(Node) bool) {
+			for k, v := range seq {
+				if !yield(f(k, v)) {
+					return
+				}
+			}
+		},
+	}
+}
+
+// EachSorted loops over the map in the order of the keys defined by less.
+func EachSorted[K comparable, V any](m map[K]V, less func(a, b K) bool, f func(K, V) Node) *EachLoop {
+	return Each2(func(yield func(K, V) bool) {
+		keys := make([]K, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+
+		sort.Slice(keys, func(i, j int) bool {
+			return less(keys[i], keys[j])
+		})
+
+		for _, k := range keys {
+			if !yield(k, m[k]) {
+				return
+			}
+		}
+	}, f)
+}
+
+// Separated renders the separator between the items, e.g. for breadcrumbs.
+func (l *EachLoop) Separated(sep Node) *EachLoop {
+	l.sep = sep
+	return l
+}
+
+// Empty renders the fallback node if the sequence has no items.
+func (l *EachLoop) Empty(fallback Node) *EachLoop {
+	l.fallback = fallback
+	return l
+}
+
+// Render renders the items of the loop.
+func (l *EachLoop) Render(w io.Writer) error {
+	var err error
+	var i int
+
+	for n := range l.seq {
+		if i > 0 && l.sep != nil {
+			if err = l.sep.Render(w); err != nil {
+				return err
+			}
+		}
+		i++
+
+		if n == nil {
+			continue
+		}
+
+		if err = n.Render(w); err != nil {
+			return err
+		}
+	}
+
+	if i == 0 && l.fallback != nil {
+		return l.fallback.Render(w)
+	}
+
+	return nil
+}
+
+// Type returns the node type.
+func (l *EachLoop) Type() NodeType {
+	return ElementType
+}
+
+// String returns the loop as a string.
+func (l *EachLoop) String() string {
+	var b strings.Builder
+
+	_ = l.Render(&b)
+
+	return b.String()
+}
+
+// Indexed returns a sequence of the items with their index.
+func Indexed[T any](seq iter.Seq[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		var i int
+		for v := range seq {
+			if !yield(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Chunked returns a sequence of chunks of the given size, e.g. for the rows of a grid.
+// The last chunk may have fewer items.
+func Chunked[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+	if size < 1 {
+		panic("chunk size must be greater than 0")
+	}
+
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0, size)
+
+		for v := range seq {
+			chunk = append(chunk, v)
+
+			if len(chunk) == size {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]T, 0, size)
+			}
+		}
+
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package htmx_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	htmx "github.com/zeiss/fiber-htmx"
+)
+
+func TestRangeMap(t *testing.T) {
+	t.Parallel()
+
+	nodes := []htmx.Node{htmx.Text("a"), htmx.Text("b")}
+
+	n := htmx.Range(nodes...).Map(func(i int) htmx.Node {
+		return htmx.Li(nodes[i])
+	}).Group()
+
+	assert.Equal(t, "<ul><li>a</li><li>b</li></ul>", htmx.Ul(n).(htmx.NodeFunc).String())
+}
+
+func TestRange(t *testing.T) {
+	t.Parallel()
+
+	nodes := []htmx.Node{htmx.Text("a"), htmx.Text("b"), htmx.Text("c"), htmx.Text("d")}
+
+	odd := func(i int) bool { return i%2 == 1 }
+	item := func(i int) htmx.Node { return htmx.Li(nodes[i]) }
+
+	tests := []struct {
+		name string
+		loop func(htmx.RangeLoop) htmx.RangeLoop
+		want string
+	}{
+		{
+			name: "range",
+			loop: func(l htmx.RangeLoop) htmx.RangeLoop { return l },
+			want: "<ul>abcd</ul>",
+		},
+		{
+			name: "filter",
+			loop: func(l htmx.RangeLoop) htmx.RangeLoop { return l.Filter(odd) },
+			want: "<ul>bd</ul>",
+		},
+		{
+			name: "filter map",
+			loop: func(l htmx.RangeLoop) htmx.RangeLoop { return l.Filter(odd).Map(item) },
+			want: "<ul><li>b</li><li>d</li></ul>",
+		},
+		{
+			name: "map filter",
+			loop: func(l htmx.RangeLoop) htmx.RangeLoop { return l.Map(item).Filter(odd) },
+			want: "<ul><li>b</li><li>d</li></ul>",
+		},
+		{
+			name: "filter filter",
+			loop: func(l htmx.RangeLoop) htmx.RangeLoop {
+				return l.Filter(odd).Filter(func(i int) bool { return i > 1 })
+			},
+			want: "<ul>d</ul>",
+		},
+		{
+			name: "filter none",
+			loop: func(l htmx.RangeLoop) htmx.RangeLoop {
+				return l.Filter(func(int) bool { return false }).Map(item)
+			},
+			want: "<ul></ul>",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			n := test.loop(htmx.Range(nodes...)).Group()
+			assert.Equal(t, test.want, htmx.Ul(n).(htmx.NodeFunc).String())
+		})
+	}
+}
+
+func TestEach(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		items []string
+		loop  func(*htmx.EachLoop) *htmx.EachLoop
+		want  string
+	}{
+		{
+			name:  "each",
+			items: []string{"a", "b", "c"},
+			loop:  func(l *htmx.EachLoop) *htmx.EachLoop { return l },
+			want:  "<ul><li>a</li><li>b</li><li>c</li></ul>",
+		},
+		{
+			name:  "separated",
+			items: []string{"a", "b", "c"},
+			loop:  func(l *htmx.EachLoop) *htmx.EachLoop { return l.Separated(htmx.Text("/")) },
+			want:  "<ul><li>a</li>/<li>b</li>/<li>c</li></ul>",
+		},
+		{
+			name:  "empty",
+			items: []string{},
+			loop:  func(l *htmx.EachLoop) *htmx.EachLoop { return l.Empty(htmx.Text("none")) },
+			want:  "<ul>none</ul>",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			l := htmx.Each(slices.Values(test.items), func(v string) htmx.Node {
+				return htmx.Li(htmx.Text(v))
+			})
+
+			assert.Equal(t, test.want, htmx.Ul(test.loop(l)).(htmx.NodeFunc).String())
+		})
+	}
+}
+
+func TestEachSorted(t *testing.T) {
+	t.Parallel()
+
+	m := map[string]int{"c": 3, "a": 1, "b": 2}
+
+	l := htmx.EachSorted(m, func(a, b string) bool { return a < b }, func(k string, v int) htmx.Node {
+		return htmx.Textf("%s=%d;", k, v)
+	})
+
+	assert.Equal(t, "a=1;b=2;c=3;", l.String())
+}
+
+func TestIndexed(t *testing.T) {
+	t.Parallel()
+
+	l := htmx.Each2(htmx.Indexed(slices.Values([]string{"a", "b"})), func(i int, v string) htmx.Node {
+		return htmx.Textf("%d:%s ", i, v)
+	})
+
+	assert.Equal(t, "0:a 1:b ", l.String())
+}
+
+func TestChunked(t *testing.T) {
+	t.Parallel()
+
+	chunks := slices.Collect(htmx.Chunked(slices.Values([]int{1, 2, 3, 4, 5}), 2))
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, chunks)
+
+	l := htmx.Each(htmx.Chunked(maps.Keys(map[int]bool{1: true}), 3), func(row []int) htmx.Node {
+		return htmx.Div(htmx.Textf("%d", len(row)))
+	})
+	assert.Equal(t, "<div>1</div>", l.String())
+}
@@ -0,0 +1,155 @@
+package htmx
+
+import (
+	"bytes"
+	"html/template"
+	"io"
+	"strings"
+)
+
+// oobContainers are the elements that cannot stand on their own in the DOM
+// and the parent elements that are used to wrap them for out-of-band swaps.
+// See: https://htmx.org/attributes/hx-swap-oob/#using-template-tags
+var oobContainers = map[string]string{
+	"caption":  "table",
+	"col":      "colgroup",
+	"colgroup": "table",
+	"tbody":    "table",
+	"td":       "tr",
+	"tfoot":    "table",
+	"th":       "tr",
+	"thead":    "table",
+	"tr":       "tbody",
+}
+
+// OOBResponse is a response that updates several regions of the page.
+// It renders a primary node followed by any number of out-of-band fragments.
+type OOBResponse struct {
+	primary   Node
+	fragments []Node
+}
+
+// NewOOBResponse returns a new out-of-band response with the primary node.
+// The primary node is swapped into the target of the request.
+func NewOOBResponse(primary Node) *OOBResponse {
+	return &OOBResponse{primary: primary}
+}
+
+// OOB adds a fragment that is swapped into the target with the given strategy.
+func (r *OOBResponse) OOB(target string, strategy HXSwapStyle, node Node) *OOBResponse {
+	r.fragments = append(r.fragments, OOB(target, strategy, node))
+	return r
+}
+
+// SelectOOB adds fragments that are selected by the hx-select-oob attribute of the requesting element.
+func (r *OOBResponse) SelectOOB(nodes ...Node) *OOBResponse {
+	r.fragments = append(r.fragments, nodes...)
+	return r
+}
+
+// Render renders the primary node and all fragments in order.
+func (r *OOBResponse) Render(w io.Writer) error {
+	if r.primary != nil {
+		if err := r.primary.Render(w); err != nil {
+			return err
+		}
+	}
+
+	for _, f := range r.fragments {
+		if err := f.Render(w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Type returns the node type.
+func (r *OOBResponse) Type() NodeType {
+	return ElementType
+}
+
+// String returns the response as a string.
+func (r *OOBResponse) String() string {
+	var b strings.Builder
+
+	_ = r.Render(&b)
+
+	return b.String()
+}
+
+// OOB returns a fragment that is swapped out-of-band into the target with the given strategy.
+// The outerHTML strategy sets the hx-swap-oob attribute on the node itself, all other strategies
+// wrap the node into a container. Table rows and cells are wrapped into a template element.
+func OOB(target string, strategy HXSwapStyle, node Node) Node {
+	return NodeFunc(func(w io.Writer) error {
+		b := newRenderBuffer(w)
+		if err := node.Render(b); err != nil {
+			return err
+		}
+
+		value := strategy.String()
+		if target != "" {
+			value += ":" + target
+		}
+
+		tag, pos := rootElement(b.Bytes())
+		container, wrap := oobContainers[tag]
+
+		var out bytes.Buffer
+
+		if wrap {
+			out.WriteString("<template>")
+		}
+
+		if strategy == HxSwapOuterHTML && pos > 0 {
+			if target == "" {
+				value = "true"
+			}
+
+			out.Write(b.Bytes()[:pos])
+			out.WriteString(` hx-swap-oob="` + template.HTMLEscapeString(value) + `"`)
+			out.Write(b.Bytes()[pos:])
+		} else {
+			if !wrap {
+				container = "div"
+			}
+
+			out.WriteString("<" + container + ` hx-swap-oob="` + template.HTMLEscapeString(value) + `">`)
+			out.Write(b.Bytes())
+			out.WriteString("</" + container + ">")
+		}
+
+		if wrap {
+			out.WriteString("</template>")
+		}
+
+		_, err := w.Write(out.Bytes())
+
+		return err
+	})
+}
+
+// rootElement returns the tag name of the first element and the position after the tag name.
+func rootElement(b []byte) (string, int) {
+	i := 0
+	for i < len(b) && (b[i] == ' ' || b[i] == '\t' || b[i] == '\n' || b[i] == '\r') {
+		i++
+	}
+
+	if i >= len(b) || b[i] != '<' {
+		return "", 0
+	}
+
+	start := i + 1
+	end := start
+	for end < len(b) && (b[end] >= 'a' && b[end] <= 'z' || b[end] >= 'A' && b[end] <= 'Z' || b[end] >= '0' && b[end] <= '9' || b[end] == '-') {
+		end++
+	}
+
+	if end == start {
+		return "", 0
+	}
+
+	return strings.ToLower(string(b[start:end])), end
+}
@@ -0,0 +1,70 @@
+package htmx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	htmx "github.com/zeiss/fiber-htmx"
+)
+
+func TestOOB(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		target   string
+		strategy htmx.HXSwapStyle
+		node     htmx.Node
+		want     string
+	}{
+		{
+			name:     "outerHTML by id",
+			strategy: htmx.HxSwapOuterHTML,
+			node:     htmx.Div(htmx.ID("alert"), htmx.Text("saved")),
+			want:     `<div hx-swap-oob="true" id="alert">saved</div>`,
+		},
+		{
+			name:     "outerHTML with target",
+			target:   "#alert",
+			strategy: htmx.HxSwapOuterHTML,
+			node:     htmx.Div(htmx.Text("saved")),
+			want:     `<div hx-swap-oob="outerHTML:#alert">saved</div>`,
+		},
+		{
+			name:     "beforeend",
+			target:   "#list",
+			strategy: htmx.HxSwapBeforeEnd,
+			node:     htmx.Li(htmx.Text("item")),
+			want:     `<div hx-swap-oob="beforeend:#list"><li>item</li></div>`,
+		},
+		{
+			name:     "table row",
+			target:   "#rows",
+			strategy: htmx.HxSwapBeforeEnd,
+			node:     htmx.Tr(htmx.Td(htmx.Text("1"))),
+			want:     `<template><tbody hx-swap-oob="beforeend:#rows"><tr><td>1</td></tr></tbody></template>`,
+		},
+		{
+			name:     "table row outerHTML",
+			strategy: htmx.HxSwapOuterHTML,
+			node:     htmx.Tr(htmx.ID("row-1"), htmx.Td(htmx.Text("1"))),
+			want:     `<template><tr hx-swap-oob="true" id="row-1"><td>1</td></tr></template>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, htmx.OOB(test.target, test.strategy, test.node).(htmx.NodeFunc).String())
+		})
+	}
+}
+
+func TestOOBResponse(t *testing.T) {
+	t.Parallel()
+
+	r := htmx.NewOOBResponse(htmx.Div(htmx.Text("main"))).
+		OOB("#count", htmx.HxSwapInnerHTML, htmx.Text("2")).
+		SelectOOB(htmx.Div(htmx.ID("info")))
+
+	assert.Equal(t, `<div>main</div><div hx-swap-oob="innerHTML:#count">2</div><div id="info"></div>`, r.String())
+}
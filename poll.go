@@ -0,0 +1,138 @@
+package htmx
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// PollIntervalParam is the request parameter that carries the current interval of a poller in milliseconds.
+const PollIntervalParam = "poll-interval"
+
+// pollVisible is the trigger filter that pauses polling while the tab is hidden.
+const pollVisible = "!document.hidden"
+
+// PollProps are the properties of a poller.
+type PollProps struct {
+	// ID is the id of the poller.
+	ID string
+	// ClassNames are the class names of the poller.
+	ClassNames ClassNames
+	// URL is the URL that is polled.
+	URL string
+	// Interval is the interval between two polls.
+	Interval time.Duration
+	// Load uses a load delay chain instead of an every trigger.
+	// The poller is only requested again after the response was swapped.
+	Load bool
+}
+
+// Poll returns a poller that replaces itself with the response of the URL in the given interval.
+// Polling pauses while the tab is hidden.
+func Poll(props PollProps, children ...Node) Node {
+	var trigger Node
+
+	if props.Load {
+		trigger = HxTriggerSpecs(
+			TriggerOnLoad().Filter(pollVisible).Delay(props.Interval),
+			TriggerOnEvent("visibilitychange").Filter(pollVisible).From("document"),
+		)
+	} else {
+		trigger = HxTriggerSpecs(TriggerEvery(props.Interval).Filter(pollVisible))
+	}
+
+	return Div(
+		If(props.ID != "", ID(props.ID)),
+		If(len(props.ClassNames) > 0, props.ClassNames),
+		HxGet(props.URL),
+		trigger,
+		HxSwap(HxSwapOuterHTML.String()),
+		HxVals(map[string]int64{PollIntervalParam: props.Interval.Milliseconds()}),
+		Group(children...),
+	)
+}
+
+// PollInterval returns the current interval of the poller that issued the request.
+// The fallback is returned if the request does not carry a valid interval.
+func PollInterval(c *fiber.Ctx, fallback time.Duration) time.Duration {
+	ms, err := strconv.ParseInt(c.Query(PollIntervalParam), 10, 64)
+	if err != nil || ms <= 0 {
+		return fallback
+	}
+
+	return time.Duration(ms) * time.Millisecond
+}
+
+// PollBackoff returns the next interval of an exponential backoff that is capped at max.
+func PollBackoff(interval time.Duration, factor float64, max time.Duration) time.Duration {
+	next := time.Duration(float64(interval) * factor)
+	if max > 0 && next > max {
+		return max
+	}
+
+	return next
+}
+
+// PollResult is the result of a poll.
+type PollResult struct {
+	// Content is the content of the poller.
+	Content Node
+	// Interval is the next interval of the poller, the current interval is kept if zero.
+	Interval time.Duration
+	// Done stops polling and renders the content without the poller.
+	Done bool
+}
+
+// PollFunc is a function that is called on every poll with the current interval.
+type PollFunc func(c *fiber.Ctx, interval time.Duration) (PollResult, error)
+
+// NewPollHandler returns a new handler for a poller.
+// The poller is re-rendered with the next interval until the result is done,
+// the final content is then rendered with 286 to stop polling.
+func NewPollHandler(props PollProps, fn PollFunc, config ...Config) fiber.Handler {
+	cfg := configDefault(config...)
+
+	return func(c *fiber.Ctx) error {
+		if cfg.Next != nil && cfg.Next(c) {
+			return c.Next()
+		}
+
+		c.Set(fiber.HeaderContentType, fiber.MIMETextHTML)
+
+		interval := PollInterval(c, props.Interval)
+
+		res, err := fn(c, interval)
+		if err != nil {
+			return cfg.ErrorHandler(c, err)
+		}
+
+		var n Node
+
+		if res.Done {
+			c.Status(StatusStopPolling)
+
+			n = Div(
+				If(props.ID != "", ID(props.ID)),
+				If(len(props.ClassNames) > 0, props.ClassNames),
+				res.Content,
+			)
+		} else {
+			p := props
+			p.Interval = interval
+
+			if res.Interval > 0 {
+				p.Interval = res.Interval
+			}
+
+			n = Poll(p, res.Content)
+		}
+
+		err = n.Render(c)
+		if err != nil {
+			return cfg.ErrorHandler(c, err)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,105 @@
+package htmx_test
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/require"
+	htmx "github.com/zeiss/fiber-htmx"
+	"github.com/zeiss/fiber-htmx/internal/assert"
+
+	testify "github.com/stretchr/testify/assert"
+)
+
+func TestPoll(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		props htmx.PollProps
+		want  string
+	}{
+		{
+			name:  "every",
+			props: htmx.PollProps{ID: "job", URL: "/job", Interval: 5 * time.Second},
+			want:  `<div id="job" hx-get="/job" hx-trigger="every 5s [!document.hidden]" hx-swap="outerHTML" hx-vals="{&#34;poll-interval&#34;:5000}">running</div>`,
+		},
+		{
+			name:  "load",
+			props: htmx.PollProps{URL: "/job", Interval: 500 * time.Millisecond, Load: true},
+			want:  `<div hx-get="/job" hx-trigger="load[!document.hidden] delay:500ms, visibilitychange[!document.hidden] from:document" hx-swap="outerHTML" hx-vals="{&#34;poll-interval&#34;:500}">running</div>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, htmx.Poll(test.props, htmx.Text("running")))
+		})
+	}
+}
+
+func TestPollBackoff(t *testing.T) {
+	t.Parallel()
+
+	testify.Equal(t, 2*time.Second, htmx.PollBackoff(time.Second, 2, time.Minute))
+	testify.Equal(t, time.Minute, htmx.PollBackoff(45*time.Second, 2, time.Minute))
+}
+
+func TestNewPollHandler(t *testing.T) {
+	t.Parallel()
+
+	props := htmx.PollProps{ID: "job", URL: "/job", Interval: time.Second}
+
+	tests := []struct {
+		name   string
+		url    string
+		done   bool
+		status int
+		want   string
+	}{
+		{
+			name:   "backoff",
+			url:    "/job?poll-interval=2000",
+			status: fiber.StatusOK,
+			want:   `<div id="job" hx-get="/job" hx-trigger="every 4s [!document.hidden]" hx-swap="outerHTML" hx-vals="{&#34;poll-interval&#34;:4000}">running</div>`,
+		},
+		{
+			name:   "first poll",
+			url:    "/job",
+			status: fiber.StatusOK,
+			want:   `<div id="job" hx-get="/job" hx-trigger="every 2s [!document.hidden]" hx-swap="outerHTML" hx-vals="{&#34;poll-interval&#34;:2000}">running</div>`,
+		},
+		{
+			name:   "done",
+			url:    "/job?poll-interval=2000",
+			done:   true,
+			status: htmx.StatusStopPolling,
+			want:   `<div id="job">finished</div>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Get("/job", htmx.NewPollHandler(props, func(c *fiber.Ctx, interval time.Duration) (htmx.PollResult, error) {
+				if test.done {
+					return htmx.PollResult{Content: htmx.Text("finished"), Done: true}, nil
+				}
+
+				return htmx.PollResult{Content: htmx.Text("running"), Interval: htmx.PollBackoff(interval, 2, time.Minute)}, nil
+			}))
+
+			resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, test.url, nil))
+			require.NoError(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			testify.Equal(t, test.status, resp.StatusCode)
+			testify.Equal(t, test.want, string(body))
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package htmx
+
+import (
+	"net/url"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/zeiss/pkg/conv"
+)
+
+// HxRequestKind is the kind of an htmx request.
+type HxRequestKind int
+
+const (
+	// HxRequestKindFullPage is a regular browser request that renders the full page.
+	HxRequestKindFullPage HxRequestKind = iota
+	// HxRequestKindBoosted is a request of a boosted link or form.
+	HxRequestKindBoosted
+	// HxRequestKindPartial is an htmx request that renders a partial.
+	HxRequestKindPartial
+	// HxRequestKindHistoryRestore is a request to restore the history after a cache miss.
+	HxRequestKindHistoryRestore
+	// HxRequestKindPrompt is an htmx request with a response to a hx-prompt.
+	HxRequestKindPrompt
+)
+
+// String returns the string representation of the request kind.
+func (k HxRequestKind) String() string {
+	switch k {
+	case HxRequestKindBoosted:
+		return "boosted"
+	case HxRequestKindPartial:
+		return "partial"
+	case HxRequestKindHistoryRestore:
+		return "history-restore"
+	case HxRequestKindPrompt:
+		return "prompt"
+	default:
+		return "full-page"
+	}
+}
+
+// HxRequest contains the values of the htmx request headers.
+// See: https://htmx.org/reference/#request_headers
+type HxRequest struct {
+	// Request is true if the request is made by htmx.
+	Request bool
+	// Boosted is true if the request is made via an element using hx-boost.
+	Boosted bool
+	// CurrentURL is the current URL of the browser.
+	CurrentURL string
+	// CurrentURLParsed is the parsed current URL of the browser, nil if it is missing or invalid.
+	CurrentURLParsed *url.URL
+	// HistoryRestoreRequest is true if the request is for history restoration after a miss in the local history cache.
+	HistoryRestoreRequest bool
+	// Prompt is the user response to an hx-prompt.
+	Prompt string
+	// Target is the id of the target element if it exists.
+	Target string
+	// Trigger is the id of the triggered element if it exists.
+	Trigger string
+	// TriggerName is the name of the triggered element if it exists.
+	TriggerName string
+}
+
+// ParseRequest parses the htmx request headers.
+func ParseRequest(c *fiber.Ctx) HxRequest {
+	r := HxRequest{
+		Request:               conv.Bool(c.Get(HxRequestHeaderRequest.String())),
+		Boosted:               conv.Bool(c.Get(HxRequestHeaderBoosted.String())),
+		CurrentURL:            c.Get(HxRequestHeaderCurrentURL.String()),
+		HistoryRestoreRequest: conv.Bool(c.Get(HxRequestHeaderHistoryRestoreRequest.String())),
+		Prompt:                c.Get(HxRequestHeaderPrompt.String()),
+		Target:                c.Get(HxRequestHeaderTarget.String()),
+		Trigger:               c.Get(HxRequestHeaderTrigger.String()),
+		TriggerName:           c.Get(HxRequestHeaderTriggerName.String()),
+	}
+
+	if r.CurrentURL != "" {
+		u, err := url.Parse(r.CurrentURL)
+		if err == nil {
+			r.CurrentURLParsed = u
+		}
+	}
+
+	return r
+}
+
+// Kind returns the kind of the request.
+func (r HxRequest) Kind() HxRequestKind {
+	switch {
+	case r.HistoryRestoreRequest:
+		return HxRequestKindHistoryRestore
+	case !r.Request:
+		return HxRequestKindFullPage
+	case r.Prompt != "":
+		return HxRequestKindPrompt
+	case r.Boosted:
+		return HxRequestKindBoosted
+	default:
+		return HxRequestKindPartial
+	}
+}
+
+// Partial returns true if the response should be rendered as a partial.
+func (r HxRequest) Partial() bool {
+	return (r.Request || r.Boosted) && !r.HistoryRestoreRequest
+}
+
+// NewHxRequestHandler returns a new middleware that parses the htmx request headers once per request.
+func NewHxRequestHandler(config ...Config) fiber.Handler {
+	cfg := configDefault(config...)
+
+	return func(c *fiber.Ctx) error {
+		if cfg.Next != nil && cfg.Next(c) {
+			return c.Next()
+		}
+
+		r := ParseRequest(c)
+		c.Locals(requestKey, &r)
+
+		return c.Next()
+	}
+}
+
+// RequestFromContext returns the parsed htmx request headers.
+// The headers are parsed and stored if the middleware has not been used.
+func RequestFromContext(c *fiber.Ctx) HxRequest {
+	r, ok := c.Locals(requestKey).(*HxRequest)
+	if !ok {
+		p := ParseRequest(c)
+		c.Locals(requestKey, &p)
+
+		return p
+	}
+
+	return *r
+}
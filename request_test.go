@@ -0,0 +1,95 @@
+package htmx_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	htmx "github.com/zeiss/fiber-htmx"
+)
+
+func TestParseRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		kind    htmx.HxRequestKind
+	}{
+		{
+			name: "full page",
+			kind: htmx.HxRequestKindFullPage,
+		},
+		{
+			name:    "partial",
+			headers: map[string]string{"HX-Request": "true", "HX-Target": "main"},
+			kind:    htmx.HxRequestKindPartial,
+		},
+		{
+			name:    "boosted",
+			headers: map[string]string{"HX-Request": "true", "HX-Boosted": "true"},
+			kind:    htmx.HxRequestKindBoosted,
+		},
+		{
+			name:    "history restore",
+			headers: map[string]string{"HX-Request": "true", "HX-History-Restore-Request": "true"},
+			kind:    htmx.HxRequestKindHistoryRestore,
+		},
+		{
+			name:    "prompt",
+			headers: map[string]string{"HX-Request": "true", "HX-Prompt": "yes"},
+			kind:    htmx.HxRequestKindPrompt,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var r htmx.HxRequest
+
+			app := fiber.New()
+			app.Use(htmx.NewHxRequestHandler())
+			app.Get("/", func(c *fiber.Ctx) error {
+				r = htmx.RequestFromContext(c)
+				return nil
+			})
+
+			req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+			for k, v := range test.headers {
+				req.Header.Set(k, v)
+			}
+
+			_, err := app.Test(req)
+			require.NoError(t, err)
+			assert.Equal(t, test.kind, r.Kind())
+		})
+	}
+}
+
+func TestParseRequestCurrentURL(t *testing.T) {
+	t.Parallel()
+
+	var r htmx.HxRequest
+
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		r = htmx.ParseRequest(c)
+		return nil
+	})
+
+	req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+	req.Header.Set("HX-Request", "true")
+	req.Header.Set("HX-Current-URL", "https://example.com/projects?page=2")
+	req.Header.Set("HX-Trigger", "save")
+	req.Header.Set("HX-Trigger-Name", "action")
+
+	_, err := app.Test(req)
+	require.NoError(t, err)
+	require.NotNil(t, r.CurrentURLParsed)
+	assert.Equal(t, "/projects", r.CurrentURLParsed.Path)
+	assert.Equal(t, "2", r.CurrentURLParsed.Query().Get("page"))
+	assert.Equal(t, "save", r.Trigger)
+	assert.Equal(t, "action", r.TriggerName)
+	assert.True(t, r.Partial())
+}
@@ -0,0 +1,245 @@
+package htmx
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+var (
+	// ErrRouteNotFound is returned when a named route does not exist.
+	ErrRouteNotFound = errors.New("htmx: route not found")
+	// ErrRouteParams is returned when the params do not match the params of a named route.
+	ErrRouteParams = errors.New("htmx: route params do not match")
+)
+
+// MethodOverrideParam is the form field that overrides the method of a form post.
+const MethodOverrideParam = "_method"
+
+// ResourceIndex is implemented by resource controllers that list the resources.
+type ResourceIndex interface {
+	// Index is called on GET /resources.
+	Index() error
+}
+
+// ResourceShow is implemented by resource controllers that show a resource.
+type ResourceShow interface {
+	// Show is called on GET /resources/:id.
+	Show() error
+}
+
+// ResourceNew is implemented by resource controllers that render the form for a new resource.
+type ResourceNew interface {
+	// New is called on GET /resources/new.
+	New() error
+}
+
+// ResourceCreate is implemented by resource controllers that create a resource.
+type ResourceCreate interface {
+	// Create is called on POST /resources.
+	Create() error
+}
+
+// ResourceEdit is implemented by resource controllers that render the form to edit a resource.
+type ResourceEdit interface {
+	// Edit is called on GET /resources/:id/edit.
+	Edit() error
+}
+
+// ResourceUpdate is implemented by resource controllers that update a resource.
+type ResourceUpdate interface {
+	// Update is called on PUT and PATCH /resources/:id.
+	Update() error
+}
+
+// ResourceDestroy is implemented by resource controllers that delete a resource.
+type ResourceDestroy interface {
+	// Destroy is called on DELETE /resources/:id.
+	Destroy() error
+}
+
+// URLFor returns the URL of the route that is named with fiber's Name in the app of the request,
+// with the params filled in order, e.g. URLFor(c, "projects.edit", id).
+func URLFor(c *fiber.Ctx, name string, params ...any) (string, error) {
+	route := c.App().GetRoute(name)
+	if route.Name != name || route.Path == "" {
+		return "", fmt.Errorf("%w: %s", ErrRouteNotFound, name)
+	}
+
+	segments := strings.Split(route.Path, "/")
+
+	i := 0
+	for j, s := range segments {
+		if !strings.HasPrefix(s, ":") {
+			continue
+		}
+
+		if i >= len(params) {
+			return "", fmt.Errorf("%w: %s", ErrRouteParams, name)
+		}
+
+		segments[j] = url.PathEscape(fmt.Sprint(params[i]))
+		i++
+	}
+
+	if i != len(params) {
+		return "", fmt.Errorf("%w: %s", ErrRouteParams, name)
+	}
+
+	return strings.Join(segments, "/"), nil
+}
+
+// MethodOverride returns a hidden input that overrides the method of a form post e.g. PUT or DELETE.
+func MethodOverride(method string) Node {
+	return Input(
+		Type("hidden"),
+		Name(MethodOverrideParam),
+		Value(method),
+	)
+}
+
+// Resource registers the conventional routes for the actions that are implemented by the controller.
+// The routes are named with fiber's Name after the path including the group prefix,
+// e.g. projects.index or admin.projects.edit. Fiber prepends the name of a named group.
+//
+//	GET    /projects          Index    projects.index
+//	GET    /projects/new      New      projects.new
+//	POST   /projects          Create   projects.create
+//	GET    /projects/:id      Show     projects.show
+//	GET    /projects/:id/edit Edit     projects.edit
+//	PUT    /projects/:id      Update   projects.update
+//	PATCH  /projects/:id      Update   projects.update
+//	DELETE /projects/:id      Destroy  projects.destroy
+//
+// Forms can post to /projects/:id with the _method field set to PUT, PATCH or DELETE,
+// the method of the request is then set to the overridden method.
+func Resource(router fiber.Router, path string, factory ControllerFactory, config ...Config) {
+	cfg := configDefault(config...)
+
+	path = "/" + strings.Trim(path, "/")
+
+	prefix := ""
+	if grp, ok := router.(*fiber.Group); ok {
+		prefix = strings.TrimSuffix(grp.Prefix, "/")
+	}
+
+	name := resourceName(prefix + path)
+
+	ctrl := probeController(factory)
+	member := path + "/:id"
+
+	handle := func(action actionFunc) fiber.Handler {
+		return func(c *fiber.Ctx) error {
+			if cfg.Next != nil && cfg.Next(c) {
+				return c.Next()
+			}
+
+			return serveController(c, factory(), cfg, action)
+		}
+	}
+
+	if _, ok := ctrl.(ResourceIndex); ok {
+		router.Get(path, handle(func(_ *fiber.Ctx, ctrl Controller) error {
+			return ctrl.(ResourceIndex).Index()
+		})).Name(name + ".index")
+	}
+
+	if _, ok := ctrl.(ResourceNew); ok {
+		router.Get(path+"/new", handle(func(_ *fiber.Ctx, ctrl Controller) error {
+			return ctrl.(ResourceNew).New()
+		})).Name(name + ".new")
+	}
+
+	if _, ok := ctrl.(ResourceCreate); ok {
+		router.Post(path, handle(func(_ *fiber.Ctx, ctrl Controller) error {
+			return ctrl.(ResourceCreate).Create()
+		})).Name(name + ".create")
+	}
+
+	if _, ok := ctrl.(ResourceShow); ok {
+		router.Get(member, handle(func(_ *fiber.Ctx, ctrl Controller) error {
+			return ctrl.(ResourceShow).Show()
+		})).Name(name + ".show")
+	}
+
+	if _, ok := ctrl.(ResourceEdit); ok {
+		router.Get(member+"/edit", handle(func(_ *fiber.Ctx, ctrl Controller) error {
+			return ctrl.(ResourceEdit).Edit()
+		})).Name(name + ".edit")
+	}
+
+	update := func(_ *fiber.Ctx, ctrl Controller) error {
+		r, ok := ctrl.(ResourceUpdate)
+		if !ok {
+			return fiber.ErrMethodNotAllowed
+		}
+
+		return r.Update()
+	}
+
+	destroy := func(_ *fiber.Ctx, ctrl Controller) error {
+		r, ok := ctrl.(ResourceDestroy)
+		if !ok {
+			return fiber.ErrMethodNotAllowed
+		}
+
+		return r.Destroy()
+	}
+
+	_, canUpdate := ctrl.(ResourceUpdate)
+	_, canDestroy := ctrl.(ResourceDestroy)
+
+	if canUpdate {
+		router.Put(member, handle(update)).Name(name + ".update")
+		router.Patch(member, handle(update))
+	}
+
+	if canDestroy {
+		router.Delete(member, handle(destroy)).Name(name + ".destroy")
+	}
+
+	if !canUpdate && !canDestroy {
+		return
+	}
+
+	router.Post(member, func(c *fiber.Ctx) error {
+		if cfg.Next != nil && cfg.Next(c) {
+			return c.Next()
+		}
+
+		method := strings.ToUpper(c.FormValue(MethodOverrideParam))
+
+		var action actionFunc
+
+		switch method {
+		case fiber.MethodPut, fiber.MethodPatch:
+			action = update
+		case fiber.MethodDelete:
+			action = destroy
+		default:
+			return cfg.ErrorHandler(c, fiber.ErrMethodNotAllowed)
+		}
+
+		c.Method(method)
+
+		return serveController(c, factory(), cfg, action)
+	})
+}
+
+// resourceName returns the name of the resource from the static segments of the path e.g. orgs.projects.
+func resourceName(path string) string {
+	parts := []string{}
+
+	for _, s := range strings.Split(path, "/") {
+		if s == "" || strings.HasPrefix(s, ":") {
+			continue
+		}
+
+		parts = append(parts, s)
+	}
+
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,169 @@
+package htmx_test
+
+import (
+	"io"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	htmx "github.com/zeiss/fiber-htmx"
+)
+
+type projectsController struct {
+	htmx.DefaultController
+}
+
+func (p *projectsController) Index() error {
+	return p.Ctx().SendString("index")
+}
+
+func (p *projectsController) Show() error {
+	return p.Ctx().SendString("show " + p.Ctx().Params("id"))
+}
+
+func (p *projectsController) Edit() error {
+	u, err := htmx.URLFor(p.Ctx(), "admin.projects.update", p.Ctx().Params("id"))
+	if err != nil {
+		return err
+	}
+
+	return p.Ctx().SendString(u)
+}
+
+func (p *projectsController) Update() error {
+	return p.Ctx().SendString("update " + p.Ctx().Params("id"))
+}
+
+func (p *projectsController) Destroy() error {
+	return p.Ctx().SendString("destroy " + p.Ctx().Params("id"))
+}
+
+func TestResource(t *testing.T) {
+	t.Parallel()
+
+	app := fiber.New()
+	htmx.Resource(app.Group("/admin"), "/projects", func() htmx.Controller {
+		return &projectsController{}
+	})
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		form   url.Values
+		status int
+		want   string
+	}{
+		{name: "index", method: fiber.MethodGet, path: "/admin/projects", status: fiber.StatusOK, want: "index"},
+		{name: "show", method: fiber.MethodGet, path: "/admin/projects/1", status: fiber.StatusOK, want: "show 1"},
+		{name: "edit", method: fiber.MethodGet, path: "/admin/projects/1/edit", status: fiber.StatusOK, want: "/admin/projects/1"},
+		{name: "update", method: fiber.MethodPatch, path: "/admin/projects/1", status: fiber.StatusOK, want: "update 1"},
+		{name: "destroy", method: fiber.MethodDelete, path: "/admin/projects/1", status: fiber.StatusOK, want: "destroy 1"},
+		{name: "override", method: fiber.MethodPost, path: "/admin/projects/1", form: url.Values{"_method": {"delete"}}, status: fiber.StatusOK, want: "destroy 1"},
+		{name: "not implemented", method: fiber.MethodPost, path: "/admin/projects", status: fiber.StatusMethodNotAllowed},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(test.method, test.path, strings.NewReader(test.form.Encode()))
+			req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationForm)
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			assert.Equal(t, test.status, resp.StatusCode)
+
+			if test.want == "" {
+				return
+			}
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, string(body))
+		})
+	}
+}
+
+func TestURLFor(t *testing.T) {
+	t.Parallel()
+
+	newApp := func(path string) *fiber.App {
+		app := fiber.New()
+		app.Get(path, func(c *fiber.Ctx) error {
+			u, err := htmx.URLFor(c, "teams.members.show", "a b", 42)
+			if err != nil {
+				return err
+			}
+
+			_, err = htmx.URLFor(c, "teams.members.show", 1)
+			assert.ErrorIs(t, err, htmx.ErrRouteParams)
+
+			_, err = htmx.URLFor(c, "unknown")
+			assert.ErrorIs(t, err, htmx.ErrRouteNotFound)
+
+			return c.SendString(u)
+		}).Name("teams.members.show")
+
+		return app
+	}
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/teams/:team/members/:id", want: "/teams/a%20b/members/42"},
+		{path: "/orgs/:org/teams/:id", want: "/orgs/a%20b/teams/42"},
+	}
+
+	apps := make([]*fiber.App, len(tests))
+	for i, test := range tests {
+		apps[i] = newApp(test.path)
+	}
+
+	for i, test := range tests {
+		resp, err := apps[i].Test(httptest.NewRequest(fiber.MethodGet, strings.NewReplacer(":team", "x", ":org", "x", ":id", "1").Replace(test.path), nil))
+		require.NoError(t, err)
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, test.want, string(body))
+	}
+}
+
+type auditedProjectsController struct {
+	projectsController
+}
+
+func TestResourceMethodOverrideFilters(t *testing.T) {
+	t.Parallel()
+
+	var calls []string
+
+	app := fiber.New()
+	htmx.Resource(app, "/projects", func() htmx.Controller {
+		return &auditedProjectsController{}
+	}, htmx.Config{
+		Chain: []htmx.ControllerFilter{
+			htmx.BeforeFilter("audit", func(c *fiber.Ctx) (htmx.Node, error) {
+				calls = append(calls, c.Method())
+				return nil, nil
+			}, fiber.MethodDelete),
+		},
+	})
+
+	req := httptest.NewRequest(fiber.MethodPost, "/projects/1", strings.NewReader(url.Values{"_method": {"DELETE"}}.Encode()))
+	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationForm)
+
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Equal(t, "destroy 1", string(body))
+	assert.Equal(t, []string{fiber.MethodDelete}, calls)
+}
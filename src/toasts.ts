@@ -11,6 +11,11 @@ type Notify = {
     code: Code;
 }
 
+// NotifyItems are the notifications of an event that is triggered more than once.
+type NotifyItems = {
+    items: Notify[];
+}
+
 type Notification = {
     id: number;
     message: Message;
@@ -27,18 +32,23 @@ export class Toasts extends LitElement {
 
     connectedCallback(): void {
         super.connectedCallback();
-        window.addEventListener('htmx-toasts:notify', ((e: CustomEvent<Notify>) => this._handleNotify(e)) as EventListener);
+        this.notifications = this.notifications.map((n, i) => ({ ...n, id: Date.now() + i }));
+        this.notifications.forEach(n => setTimeout(() => this._remove(n), 3000));
+        window.addEventListener('htmx-toasts:notify', ((e: CustomEvent<Notify | NotifyItems>) => this._handleNotify(e)) as EventListener);
     }
 
     disconnectedCallback(): void {
         super.disconnectedCallback();
-        window.removeEventListener('htmx-toasts:notify', ((e: CustomEvent<Notify>) => this._handleNotify(e)) as EventListener);
+        window.removeEventListener('htmx-toasts:notify', ((e: CustomEvent<Notify | NotifyItems>) => this._handleNotify(e)) as EventListener);
     }
 
-    private _handleNotify(e: CustomEvent<Notify>): void {
-        const notifcation = { id: e.timeStamp, ...e.detail }
-        this.notifications.push(notifcation);
-        setTimeout(() => this._remove(notifcation), 3000);
+    private _handleNotify(e: CustomEvent<Notify | NotifyItems>): void {
+        const details = 'items' in e.detail ? e.detail.items : [e.detail];
+        details.forEach((detail, i) => {
+            const notifcation = { id: e.timeStamp + i, ...detail }
+            this.notifications.push(notifcation);
+            setTimeout(() => this._remove(notifcation), 3000);
+        });
         this.requestUpdate();
     }
 
@@ -19,6 +19,7 @@ type Swap struct {
 	style       HXSwapStyle
 	transition  *bool
 	timing      *HxSwapTiming
+	settle      *HxSwapTiming
 	scrolling   *HxSwapScrolling
 	ignoreTitle *bool
 	focusScroll *bool
@@ -37,7 +38,7 @@ func (s *HxSwapTiming) String() string {
 	out = string(s.mode)
 
 	if s.duration != 0 {
-		out += ":" + s.duration.String()
+		out += ":" + FormatInterval(s.duration)
 	}
 
 	return out
@@ -124,6 +125,26 @@ func (s *Swap) ShowBottom(target ...string) *Swap {
 	return s.Show(HxSwapDirectionBottom, target...)
 }
 
+// ScrollWindow scrolls the window to the given direction after the swap.
+func (s *Swap) ScrollWindow(direction HxSwapDirection) *Swap {
+	return s.Scroll(direction, HxSwapScrollTargetWindow)
+}
+
+// ShowWindow scrolls the window to show the given direction after the swap.
+func (s *Swap) ShowWindow(direction HxSwapDirection) *Swap {
+	return s.Show(direction, HxSwapScrollTargetWindow)
+}
+
+// ShowNone disables scrolling the target into view after the swap.
+func (s *Swap) ShowNone() *Swap {
+	s.scrolling = &HxSwapScrolling{
+		mode:   HxSwapScrollingShow,
+		target: HxSwapScrollTargetNone,
+	}
+
+	return s
+}
+
 func (s *Swap) setTiming(mode HxSwapTimingMode, swap ...time.Duration) *Swap {
 	var duration time.Duration
 
@@ -138,10 +159,17 @@ func (s *Swap) setTiming(mode HxSwapTimingMode, swap ...time.Duration) *Swap {
 		}
 	}
 
-	s.timing = &HxSwapTiming{
+	timing := &HxSwapTiming{
 		mode:     mode,
 		duration: duration,
 	}
+
+	if mode == HxTimingSettle {
+		s.settle = timing
+		return s
+	}
+
+	s.timing = timing
 	return s
 }
 
@@ -152,7 +180,7 @@ func (s *Swap) Swap(swap ...time.Duration) *Swap {
 
 // Settle modifies the amount of time that htmx will wait after receiving a response to settle the content
 func (s *Swap) Settle(swap ...time.Duration) *Swap {
-	return s.setTiming(HxTimingSwap, swap...)
+	return s.setTiming(HxTimingSettle, swap...)
 }
 
 // Transition ...
@@ -199,6 +227,10 @@ func (s *Swap) String() string {
 		parts = append(parts, s.timing.String())
 	}
 
+	if s.settle != nil {
+		parts = append(parts, s.settle.String())
+	}
+
 	return strings.Join(parts, " ")
 }
 
@@ -219,6 +251,14 @@ const (
 	HxSwapAfterEnd    HXSwapStyle = "afterend"
 	HxSwapDelete      HXSwapStyle = "delete"
 	HxSwapNone        HXSwapStyle = "none"
+	HxSwapTextContent HXSwapStyle = "textContent"
+)
+
+// Morph swap styles of the idiomorph extension, see HxExtMorph.
+const (
+	HxSwapMorph          HXSwapStyle = "morph"
+	HxSwapMorphInnerHTML HXSwapStyle = "morph:innerHTML"
+	HxSwapMorphOuterHTML HXSwapStyle = "morph:outerHTML"
 )
 
 // HxSwapScrollingMode ...
@@ -259,3 +299,24 @@ const (
 	HxSwapDirectionTop    HxSwapDirection = "top"
 	HxSwapDirectionBottom HxSwapDirection = "bottom"
 )
+
+const (
+	// HxSwapScrollTargetWindow scrolls the window instead of an element.
+	HxSwapScrollTargetWindow = "window"
+	// HxSwapScrollTargetNone disables scrolling.
+	HxSwapScrollTargetNone = "none"
+)
+
+// ViewTransitionName sets the style attribute to the view-transition-name of the element,
+// so the element is animated between swaps with view transitions e.g. when reordering a list.
+// It must be the only style attribute of the element, use ViewTransitionNameStyle
+// to add the view-transition-name to other styles.
+func ViewTransitionName(name string) Node {
+	return StyleAttribute(ViewTransitionNameStyle(name))
+}
+
+// ViewTransitionNameStyle returns the view-transition-name declaration of the element
+// to be used with other styles e.g. StyleAttribute("color: red; " + ViewTransitionNameStyle("item-1")).
+func ViewTransitionNameStyle(name string) string {
+	return "view-transition-name: " + name
+}
@@ -0,0 +1,95 @@
+package htmx_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	htmx "github.com/zeiss/fiber-htmx"
+)
+
+func TestSwap(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		swap *htmx.Swap
+		want string
+	}{
+		{
+			name: "default",
+			swap: htmx.NewSwap(),
+			want: "innerHTML",
+		},
+		{
+			name: "settle",
+			swap: htmx.NewSwap().Settle(),
+			want: "innerHTML settle:20ms",
+		},
+		{
+			name: "swap and settle",
+			swap: htmx.NewSwap().Style(htmx.HxSwapOuterHTML).Swap(time.Second).Settle(100 * time.Millisecond),
+			want: "outerHTML swap:1s settle:100ms",
+		},
+		{
+			name: "morph with transition",
+			swap: htmx.NewSwap().Style(htmx.HxSwapMorphOuterHTML).Transition(true),
+			want: "morph:outerHTML transition:true",
+		},
+		{
+			name: "text content",
+			swap: htmx.NewSwap().Style(htmx.HxSwapTextContent),
+			want: "textContent",
+		},
+		{
+			name: "show window",
+			swap: htmx.NewSwap().ShowWindow(htmx.HxSwapDirectionTop),
+			want: "innerHTML show:window:top",
+		},
+		{
+			name: "scroll selector",
+			swap: htmx.NewSwap().ScrollBottom("#messages"),
+			want: "innerHTML scroll:#messages:bottom",
+		},
+		{
+			name: "show none",
+			swap: htmx.NewSwap().ShowNone(),
+			want: "innerHTML show:none",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.swap.String())
+		})
+	}
+}
+
+func TestViewTransitionName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		node htmx.Node
+		want string
+	}{
+		{
+			name: "attribute",
+			node: htmx.Li(htmx.ViewTransitionName("item-1")),
+			want: `<li style="view-transition-name: item-1"></li>`,
+		},
+		{
+			name: "style",
+			node: htmx.Li(htmx.StyleAttribute("color: red; " + htmx.ViewTransitionNameStyle("item-1"))),
+			want: `<li style="color: red; view-transition-name: item-1"></li>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.want, test.node.(htmx.NodeFunc).String())
+		})
+	}
+}
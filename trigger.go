@@ -0,0 +1,264 @@
+package htmx
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrTriggerEvent is returned when a trigger has no event.
+	ErrTriggerEvent = errors.New("htmx: trigger event is required")
+	// ErrTriggerInterval is returned when a trigger has an invalid interval.
+	ErrTriggerInterval = errors.New("htmx: trigger interval must be greater than 0")
+	// ErrTriggerThreshold is returned when a trigger has an invalid intersect threshold.
+	ErrTriggerThreshold = errors.New("htmx: trigger threshold must be between 0 and 1")
+	// ErrTriggerModifier is returned when a modifier is not allowed for the trigger.
+	ErrTriggerModifier = errors.New("htmx: trigger modifier is not allowed")
+)
+
+// List of special trigger events.
+const (
+	TriggerEventLoad      = "load"
+	TriggerEventRevealed  = "revealed"
+	TriggerEventIntersect = "intersect"
+	TriggerEventEvery     = "every"
+)
+
+// HxTriggerQueue is the queue strategy of a trigger.
+type HxTriggerQueue string
+
+// String returns the string representation of the queue strategy.
+func (q HxTriggerQueue) String() string {
+	return string(q)
+}
+
+const (
+	HxTriggerQueueFirst HxTriggerQueue = "first"
+	HxTriggerQueueLast  HxTriggerQueue = "last"
+	HxTriggerQueueAll   HxTriggerQueue = "all"
+	HxTriggerQueueNone  HxTriggerQueue = "none"
+)
+
+// HxTriggerSpec is a single trigger specification of the hx-trigger attribute.
+// See: https://htmx.org/attributes/hx-trigger/
+type HxTriggerSpec struct {
+	event     string
+	filter    string
+	interval  time.Duration
+	modifiers []string
+	intersect bool
+	err       error
+}
+
+// TriggerOn returns a trigger for a DOM event.
+func TriggerOn(event JSEventType) *HxTriggerSpec {
+	return TriggerOnEvent(strings.TrimPrefix(event.String(), "on"))
+}
+
+// TriggerOnHx returns a trigger for an htmx event.
+func TriggerOnHx(event HxEventType) *HxTriggerSpec {
+	return TriggerOnEvent(event.String())
+}
+
+// TriggerOnEvent returns a trigger for an event by name.
+func TriggerOnEvent(event string) *HxTriggerSpec {
+	return &HxTriggerSpec{event: event}
+}
+
+// TriggerOnLoad returns a trigger that fires when the element is loaded.
+func TriggerOnLoad() *HxTriggerSpec {
+	return TriggerOnEvent(TriggerEventLoad)
+}
+
+// TriggerOnRevealed returns a trigger that fires when the element is scrolled into the viewport.
+func TriggerOnRevealed() *HxTriggerSpec {
+	return TriggerOnEvent(TriggerEventRevealed)
+}
+
+// TriggerOnIntersect returns a trigger that fires once when the element first intersects the viewport.
+func TriggerOnIntersect() *HxTriggerSpec {
+	return &HxTriggerSpec{event: TriggerEventIntersect, intersect: true}
+}
+
+// TriggerEvery returns a trigger that polls with the given interval.
+func TriggerEvery(interval time.Duration) *HxTriggerSpec {
+	t := &HxTriggerSpec{event: TriggerEventEvery, interval: interval}
+	if interval <= 0 {
+		t.err = ErrTriggerInterval
+	}
+
+	return t
+}
+
+func (t *HxTriggerSpec) modifier(m string) *HxTriggerSpec {
+	if t.event == TriggerEventEvery && t.err == nil {
+		t.err = fmt.Errorf("%w: %s on %s", ErrTriggerModifier, m, TriggerEventEvery)
+	}
+
+	t.modifiers = append(t.modifiers, m)
+
+	return t
+}
+
+func (t *HxTriggerSpec) timing(name string, d time.Duration) *HxTriggerSpec {
+	if d <= 0 && t.err == nil {
+		t.err = fmt.Errorf("%w: %s", ErrTriggerInterval, name)
+	}
+
+	return t.modifier(name + ":" + FormatInterval(d))
+}
+
+// Filter sets the event filter, a JavaScript expression that is evaluated against the event e.g. ctrlKey.
+func (t *HxTriggerSpec) Filter(expr string) *HxTriggerSpec {
+	t.filter = expr
+	return t
+}
+
+// Once triggers the event only once.
+func (t *HxTriggerSpec) Once() *HxTriggerSpec {
+	return t.modifier("once")
+}
+
+// Changed triggers the event only if the value of the element has changed.
+func (t *HxTriggerSpec) Changed() *HxTriggerSpec {
+	return t.modifier("changed")
+}
+
+// Delay waits the given amount of time before issuing the request.
+func (t *HxTriggerSpec) Delay(d time.Duration) *HxTriggerSpec {
+	return t.timing("delay", d)
+}
+
+// Throttle discards events within the given amount of time after the first event.
+func (t *HxTriggerSpec) Throttle(d time.Duration) *HxTriggerSpec {
+	return t.timing("throttle", d)
+}
+
+// From listens for the event on a different element, e.g. document, window or closest form.
+func (t *HxTriggerSpec) From(selector string) *HxTriggerSpec {
+	return t.modifier("from:" + selector)
+}
+
+// Target filters the event by the target of the event.
+func (t *HxTriggerSpec) Target(selector string) *HxTriggerSpec {
+	return t.modifier("target:" + selector)
+}
+
+// Consume stops the event from propagating to parent elements.
+func (t *HxTriggerSpec) Consume() *HxTriggerSpec {
+	return t.modifier("consume")
+}
+
+// Queue determines how events are queued while a request is in flight.
+func (t *HxTriggerSpec) Queue(q HxTriggerQueue) *HxTriggerSpec {
+	return t.modifier("queue:" + q.String())
+}
+
+// Root sets the root element of an intersect trigger.
+func (t *HxTriggerSpec) Root(selector string) *HxTriggerSpec {
+	if !t.intersect && t.err == nil {
+		t.err = fmt.Errorf("%w: root on %s", ErrTriggerModifier, t.event)
+	}
+
+	return t.modifier("root:" + selector)
+}
+
+// Threshold sets the threshold of an intersect trigger between 0.0 and 1.0.
+func (t *HxTriggerSpec) Threshold(v float64) *HxTriggerSpec {
+	if !t.intersect && t.err == nil {
+		t.err = fmt.Errorf("%w: threshold on %s", ErrTriggerModifier, t.event)
+	}
+
+	if (v < 0 || v > 1) && t.err == nil {
+		t.err = ErrTriggerThreshold
+	}
+
+	return t.modifier("threshold:" + strconv.FormatFloat(v, 'f', -1, 64))
+}
+
+// Validate returns an error if the trigger is invalid.
+func (t *HxTriggerSpec) Validate() error {
+	if t.err != nil {
+		return t.err
+	}
+
+	if strings.TrimSpace(t.event) == "" {
+		return ErrTriggerEvent
+	}
+
+	return nil
+}
+
+// String returns the trigger specification.
+func (t *HxTriggerSpec) String() string {
+	var b strings.Builder
+
+	b.WriteString(t.event)
+
+	if t.event == TriggerEventEvery {
+		b.WriteString(" " + FormatInterval(t.interval))
+
+		if t.filter != "" {
+			b.WriteString(" ")
+		}
+	}
+
+	if t.filter != "" {
+		b.WriteString("[" + t.filter + "]")
+	}
+
+	for _, m := range t.modifiers {
+		b.WriteString(" " + m)
+	}
+
+	return b.String()
+}
+
+type triggerAttr struct {
+	specs []*HxTriggerSpec
+}
+
+// HxTriggerSpecs sets the hx-trigger attribute to the given trigger specifications.
+// Rendering fails if one of the specifications is invalid.
+func HxTriggerSpecs(specs ...*HxTriggerSpec) Node {
+	return triggerAttr{specs: specs}
+}
+
+// Render renders the hx-trigger attribute.
+func (a triggerAttr) Render(w io.Writer) error {
+	parts := make([]string, 0, len(a.specs))
+
+	for _, s := range a.specs {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+
+		parts = append(parts, s.String())
+	}
+
+	return Attribute(HxAttributeTrigger.String(), strings.Join(parts, ", ")).Render(w)
+}
+
+// Type returns the node type.
+func (a triggerAttr) Type() NodeType {
+	return AttributeType
+}
+
+// FormatInterval formats a duration as an htmx time interval e.g. 500ms, 2s or 1m.
+// Fractions of a millisecond are rounded up, so a positive duration is never formatted as no delay.
+func FormatInterval(d time.Duration) string {
+	switch {
+	case d >= time.Minute && d%time.Minute == 0:
+		return strconv.FormatInt(int64(d/time.Minute), 10) + "m"
+	case d%time.Second == 0:
+		return strconv.FormatInt(int64(d/time.Second), 10) + "s"
+	case d > 0:
+		return strconv.FormatInt(int64((d+time.Millisecond-1)/time.Millisecond), 10) + "ms"
+	default:
+		return strconv.FormatInt(d.Milliseconds(), 10) + "ms"
+	}
+}
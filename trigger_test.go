@@ -0,0 +1,139 @@
+package htmx_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	htmx "github.com/zeiss/fiber-htmx"
+)
+
+func TestHxTriggerSpecs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		specs []*htmx.HxTriggerSpec
+		want  string
+	}{
+		{
+			name:  "dom event",
+			specs: []*htmx.HxTriggerSpec{htmx.TriggerOn(htmx.JSEventTypeClickEvent).Filter("ctrlKey").Once()},
+			want:  ` hx-trigger="click[ctrlKey] once"`,
+		},
+		{
+			name:  "search input",
+			specs: []*htmx.HxTriggerSpec{htmx.TriggerOn(htmx.JSEventTypeKeyUpEvent).Changed().Delay(500 * time.Millisecond)},
+			want:  ` hx-trigger="keyup changed delay:500ms"`,
+		},
+		{
+			name:  "htmx event",
+			specs: []*htmx.HxTriggerSpec{htmx.TriggerOnHx(htmx.HxEventTypeAfterRequest).From("body").Queue(htmx.HxTriggerQueueLast)},
+			want:  ` hx-trigger="htmx:afterRequest from:body queue:last"`,
+		},
+		{
+			name:  "polling",
+			specs: []*htmx.HxTriggerSpec{htmx.TriggerEvery(2 * time.Second).Filter("visible")},
+			want:  ` hx-trigger="every 2s [visible]"`,
+		},
+		{
+			name:  "intersect",
+			specs: []*htmx.HxTriggerSpec{htmx.TriggerOnIntersect().Root("#list").Threshold(0.5)},
+			want:  ` hx-trigger="intersect root:#list threshold:0.5"`,
+		},
+		{
+			name:  "sub millisecond delay",
+			specs: []*htmx.HxTriggerSpec{htmx.TriggerOn(htmx.JSEventTypeKeyUpEvent).Delay(500 * time.Microsecond)},
+			want:  ` hx-trigger="keyup delay:1ms"`,
+		},
+		{
+			name: "multiple",
+			specs: []*htmx.HxTriggerSpec{
+				htmx.TriggerOnLoad(),
+				htmx.TriggerOnEvent("refresh").From("window").Throttle(time.Minute),
+				htmx.TriggerOnRevealed().Consume(),
+			},
+			want: ` hx-trigger="load, refresh from:window throttle:1m, revealed consume"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var b strings.Builder
+			require.NoError(t, htmx.HxTriggerSpecs(test.specs...).Render(&b))
+			assert.Equal(t, test.want, b.String())
+		})
+	}
+}
+
+func TestHxTriggerSpecsInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		spec *htmx.HxTriggerSpec
+		err  error
+	}{
+		{
+			name: "missing event",
+			spec: htmx.TriggerOnEvent(""),
+			err:  htmx.ErrTriggerEvent,
+		},
+		{
+			name: "invalid interval",
+			spec: htmx.TriggerEvery(0),
+			err:  htmx.ErrTriggerInterval,
+		},
+		{
+			name: "modifier on polling",
+			spec: htmx.TriggerEvery(time.Second).Once(),
+			err:  htmx.ErrTriggerModifier,
+		},
+		{
+			name: "threshold without intersect",
+			spec: htmx.TriggerOn(htmx.JSEventTypeClickEvent).Threshold(0.5),
+			err:  htmx.ErrTriggerModifier,
+		},
+		{
+			name: "threshold out of range",
+			spec: htmx.TriggerOnIntersect().Threshold(2),
+			err:  htmx.ErrTriggerThreshold,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var b strings.Builder
+			require.ErrorIs(t, htmx.HxTriggerSpecs(test.spec).Render(&b), test.err)
+		})
+	}
+}
+
+func TestFormatInterval(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{d: 0, want: "0s"},
+		{d: time.Nanosecond, want: "1ms"},
+		{d: 999 * time.Microsecond, want: "1ms"},
+		{d: time.Millisecond, want: "1ms"},
+		{d: 1500 * time.Microsecond, want: "2ms"},
+		{d: 500 * time.Millisecond, want: "500ms"},
+		{d: 2 * time.Second, want: "2s"},
+		{d: 90 * time.Second, want: "90s"},
+		{d: time.Minute, want: "1m"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.d.String(), func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.want, htmx.FormatInterval(test.d))
+		})
+	}
+}
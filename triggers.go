@@ -0,0 +1,249 @@
+package htmx
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// triggerHeaders are the response headers that trigger client side events in the order they are written.
+var triggerHeaders = []HxResponseHeader{HXTrigger, HXTriggerAfterSwap, HXTriggerAfterSettle}
+
+// TriggerItemsKey is the key of the details of an event that is triggered more than once,
+// e.g. {"notify":{"items":[{"message":"a"},{"message":"b"}]}}. htmx passes object details
+// to the event as is and wraps all other details, so repeated details are sent as an object.
+const TriggerItemsKey = "items"
+
+type triggerEvent struct {
+	name    string
+	details []any
+}
+
+// MarshalJSON returns the JSON encoding of the event details.
+// A single detail is encoded as is, multiple details are encoded as the items of an object.
+func (e *triggerEvent) MarshalJSON() ([]byte, error) {
+	switch len(e.details) {
+	case 0:
+		return []byte("null"), nil
+	case 1:
+		return json.Marshal(e.details[0])
+	default:
+		return json.Marshal(map[string][]any{TriggerItemsKey: e.details})
+	}
+}
+
+// triggerItems returns the details of an event that is triggered more than once.
+func triggerItems(detail any) ([]any, bool) {
+	m, ok := detail.(map[string]any)
+	if !ok || len(m) != 1 {
+		return nil, false
+	}
+
+	items, ok := m[TriggerItemsKey].([]any)
+
+	return items, ok
+}
+
+// Triggers collects the client side events of a request.
+// The events are merged into one well-formed header per phase when they are written.
+type Triggers struct {
+	events  map[HxResponseHeader][]*triggerEvent
+	written map[HxResponseHeader]string
+}
+
+// NewTriggers returns a new collector for client side events.
+func NewTriggers() *Triggers {
+	return &Triggers{
+		events:  make(map[HxResponseHeader][]*triggerEvent),
+		written: make(map[HxResponseHeader]string),
+	}
+}
+
+// Add adds an event with optional details to the given header.
+// Details of events with the same name are merged into the items of an object, see TriggerItemsKey.
+func (t *Triggers) Add(header HxResponseHeader, name string, detail ...any) *Triggers {
+	for _, e := range t.events[header] {
+		if e.name == name {
+			e.details = append(e.details, detail...)
+			return t
+		}
+	}
+
+	t.events[header] = append(t.events[header], &triggerEvent{name: name, details: detail})
+
+	return t
+}
+
+// Trigger adds an event that is triggered as soon as the response is received.
+func (t *Triggers) Trigger(name string, detail ...any) *Triggers {
+	return t.Add(HXTrigger, name, detail...)
+}
+
+// AfterSwap adds an event that is triggered after the swap step.
+func (t *Triggers) AfterSwap(name string, detail ...any) *Triggers {
+	return t.Add(HXTriggerAfterSwap, name, detail...)
+}
+
+// AfterSettle adds an event that is triggered after the settle step.
+func (t *Triggers) AfterSettle(name string, detail ...any) *Triggers {
+	return t.Add(HXTriggerAfterSettle, name, detail...)
+}
+
+// Parse parses a raw header value and adds its events to the given header.
+// The value is either a JSON object or a comma separated list of event names.
+// The items of repeated events are added as separate details.
+func (t *Triggers) Parse(header HxResponseHeader, raw string) error {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil
+	}
+
+	if !strings.HasPrefix(raw, "{") {
+		for _, name := range strings.Split(raw, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				t.Add(header, name)
+			}
+		}
+
+		return nil
+	}
+
+	dec := json.NewDecoder(strings.NewReader(raw))
+	dec.UseNumber()
+
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		name, _ := tok.(string)
+
+		var detail any
+		if err := dec.Decode(&detail); err != nil {
+			return err
+		}
+
+		if detail == nil {
+			t.Add(header, name)
+			continue
+		}
+
+		if items, ok := triggerItems(detail); ok {
+			t.Add(header, name, items...)
+			continue
+		}
+
+		t.Add(header, name, detail)
+	}
+
+	return nil
+}
+
+// Header returns the value of the given header.
+// Events without details are written as a list of names.
+func (t *Triggers) Header(header HxResponseHeader) (string, error) {
+	events := t.events[header]
+	if len(events) == 0 {
+		return "", nil
+	}
+
+	names := make([]string, 0, len(events))
+	for _, e := range events {
+		if len(e.details) > 0 {
+			names = nil
+			break
+		}
+		names = append(names, e.name)
+	}
+
+	if names != nil {
+		return strings.Join(names, ", "), nil
+	}
+
+	var b bytes.Buffer
+	b.WriteString("{")
+
+	for i, e := range events {
+		if i > 0 {
+			b.WriteString(",")
+		}
+
+		name, err := json.Marshal(e.name)
+		if err != nil {
+			return "", err
+		}
+
+		detail, err := json.Marshal(e)
+		if err != nil {
+			return "", err
+		}
+
+		b.Write(name)
+		b.WriteString(":")
+		b.Write(detail)
+	}
+
+	b.WriteString("}")
+
+	return b.String(), nil
+}
+
+// Write writes the headers to the response.
+// Header values that have been set without the collector are merged into it.
+func (t *Triggers) Write(c *fiber.Ctx) error {
+	for _, header := range triggerHeaders {
+		if v := c.GetRespHeader(header.String()); v != "" && v != t.written[header] {
+			if err := t.Parse(header, v); err != nil {
+				return err
+			}
+		}
+
+		v, err := t.Header(header)
+		if err != nil {
+			return err
+		}
+
+		t.written[header] = v
+
+		if v == "" {
+			continue
+		}
+
+		c.Set(header.String(), v)
+	}
+
+	return nil
+}
+
+// TriggersFromContext returns the collector for client side events of the request.
+func TriggersFromContext(c *fiber.Ctx) *Triggers {
+	t, ok := c.Locals(triggersKey).(*Triggers)
+	if !ok {
+		t = NewTriggers()
+		c.Locals(triggersKey, t)
+	}
+
+	return t
+}
+
+// TriggerEvent is a helper function to trigger an event as soon as the response is received.
+func TriggerEvent(c *fiber.Ctx, name string, detail ...any) error {
+	return TriggersFromContext(c).Trigger(name, detail...).Write(c)
+}
+
+// TriggerEventAfterSwap is a helper function to trigger an event after the swap step.
+func TriggerEventAfterSwap(c *fiber.Ctx, name string, detail ...any) error {
+	return TriggersFromContext(c).AfterSwap(name, detail...).Write(c)
+}
+
+// TriggerEventAfterSettle is a helper function to trigger an event after the settle step.
+func TriggerEventAfterSettle(c *fiber.Ctx, name string, detail ...any) error {
+	return TriggersFromContext(c).AfterSettle(name, detail...).Write(c)
+}
@@ -0,0 +1,103 @@
+package htmx_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	htmx "github.com/zeiss/fiber-htmx"
+)
+
+func TestTriggersHeader(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		triggers *htmx.Triggers
+		header   htmx.HxResponseHeader
+		want     string
+	}{
+		{
+			name:     "empty",
+			triggers: htmx.NewTriggers(),
+			header:   htmx.HXTrigger,
+			want:     "",
+		},
+		{
+			name:     "names",
+			triggers: htmx.NewTriggers().Trigger("a").Trigger("b"),
+			header:   htmx.HXTrigger,
+			want:     "a, b",
+		},
+		{
+			name:     "details",
+			triggers: htmx.NewTriggers().Trigger("a").Trigger("b", map[string]int{"id": 1}),
+			header:   htmx.HXTrigger,
+			want:     `{"a":null,"b":{"id":1}}`,
+		},
+		{
+			name:     "merge details",
+			triggers: htmx.NewTriggers().Trigger("a", "x").Trigger("a", "y"),
+			header:   htmx.HXTrigger,
+			want:     `{"a":{"items":["x","y"]}}`,
+		},
+		{
+			name:     "after settle",
+			triggers: htmx.NewTriggers().Trigger("a").AfterSettle("b", 1),
+			header:   htmx.HXTriggerAfterSettle,
+			want:     `{"b":1}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v, err := test.triggers.Header(test.header)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, v)
+		})
+	}
+}
+
+func TestTriggersParse(t *testing.T) {
+	t.Parallel()
+
+	triggers := htmx.NewTriggers()
+	require.NoError(t, triggers.Parse(htmx.HXTrigger, `{"b":{"id":1},"a":null}`))
+	require.NoError(t, triggers.Parse(htmx.HXTrigger, "c, d"))
+	require.NoError(t, triggers.Parse(htmx.HXTrigger, `{"e":{"items":["x","y"]}}`))
+
+	assert.Equal(t, []any{"x", "y"}, triggers.Details(htmx.HXTrigger, "e"))
+
+	v, err := triggers.Header(htmx.HXTrigger)
+	require.NoError(t, err)
+	assert.Equal(t, `{"b":{"id":1},"a":null,"c":null,"d":null,"e":{"items":["x","y"]}}`, v)
+}
+
+func TestTriggerEventWithMessages(t *testing.T) {
+	t.Parallel()
+
+	app := fiber.New()
+	app.Use(htmx.NewHtmxMessageHandler())
+	app.Get("/", func(c *fiber.Ctx) error {
+		htmx.HxTriggers(c, "raw")
+
+		err := htmx.TriggerEvent(c, "notify", map[string]string{"level": "info"})
+		if err != nil {
+			return err
+		}
+
+		htmx.MessagesFromContext(c).Add(htmx.HtmxMessage{Message: "saved", Tags: "success"})
+
+		return htmx.TriggerEventAfterSwap(c, "swapped")
+	})
+
+	req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+	req.Header.Set(htmx.HxRequestHeaderRequest.String(), "true")
+
+	res, err := app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, `{"messages":[{"message":"saved","tags":"success"}],"raw":null,"notify":{"level":"info"}}`, res.Header.Get(htmx.HXTrigger.String()))
+	assert.Equal(t, "swapped", res.Header.Get(htmx.HXTriggerAfterSwap.String()))
+}
@@ -0,0 +1,174 @@
+package htmx_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	htmx "github.com/zeiss/fiber-htmx"
+	"gorm.io/gorm"
+	"gorm.io/gorm/utils/tests"
+)
+
+type txLog struct {
+	sync.Mutex
+	events []string
+}
+
+func (l *txLog) add(event string) {
+	l.Lock()
+	defer l.Unlock()
+
+	l.events = append(l.events, event)
+}
+
+type txPool struct {
+	gorm.ConnPool
+	log *txLog
+}
+
+func (p *txPool) BeginTx(_ context.Context, opts *sql.TxOptions) (gorm.ConnPool, error) {
+	p.log.add(fmt.Sprintf("begin readonly=%v", opts != nil && opts.ReadOnly))
+	return &txConn{log: p.log}, nil
+}
+
+type txConn struct {
+	gorm.ConnPool
+	log *txLog
+}
+
+func (t *txConn) Commit() error {
+	t.log.add("commit")
+	return nil
+}
+
+func (t *txConn) Rollback() error {
+	t.log.add("rollback")
+	return nil
+}
+
+type txDialector struct {
+	tests.DummyDialector
+	log *txLog
+}
+
+func (d txDialector) SavePoint(_ *gorm.DB, name string) error {
+	d.log.add("savepoint")
+	return nil
+}
+
+func (d txDialector) RollbackTo(_ *gorm.DB, name string) error {
+	d.log.add("rollback to savepoint")
+	return nil
+}
+
+type txController struct {
+	*htmx.DefaultTransactionController
+	fail  bool
+	panic bool
+	next  bool
+}
+
+func (c *txController) Get() error {
+	return c.Ctx().SendString("ok")
+}
+
+func (c *txController) Post() error {
+	if c.next {
+		_ = c.Ctx().Next() // the nested transaction is rolled back to its savepoint
+
+		return nil
+	}
+
+	if c.panic {
+		panic("boom")
+	}
+
+	if c.fail {
+		return errors.New("failed")
+	}
+
+	return c.Ctx().SendString("ok")
+}
+
+func TestTransactionLifecycle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		method     string
+		fail       bool
+		panic      bool
+		savePoints bool
+		outer      bool
+		want       []string
+	}{
+		{
+			name:   "commit",
+			method: fiber.MethodPost,
+			want:   []string{"begin readonly=false", "commit"},
+		},
+		{
+			name:   "read only",
+			method: fiber.MethodGet,
+			want:   []string{"begin readonly=true", "commit"},
+		},
+		{
+			name:   "rollback on error",
+			method: fiber.MethodPost,
+			fail:   true,
+			want:   []string{"begin readonly=false", "rollback"},
+		},
+		{
+			name:   "rollback on panic",
+			method: fiber.MethodPost,
+			panic:  true,
+			want:   []string{"begin readonly=false", "rollback"},
+		},
+		{
+			name:       "savepoint",
+			method:     fiber.MethodPost,
+			fail:       true,
+			savePoints: true,
+			outer:      true,
+			want:       []string{"begin readonly=false", "savepoint", "rollback to savepoint", "commit"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			log := &txLog{}
+
+			db, err := gorm.Open(txDialector{log: log}, &gorm.Config{ConnPool: &txPool{log: log}})
+			require.NoError(t, err)
+
+			app := fiber.New()
+
+			cfg := htmx.Config{DB: db, SavePoints: test.savePoints}
+
+			if test.outer {
+				app.Add(test.method, "/", htmx.NewControllerHandler(func() htmx.Controller {
+					return &txController{DefaultTransactionController: htmx.NewTransactionController(), next: true}
+				}, cfg))
+			}
+
+			handler := htmx.NewControllerHandler(func() htmx.Controller {
+				return &txController{DefaultTransactionController: htmx.NewTransactionController(), fail: test.fail, panic: test.panic}
+			}, cfg)
+
+			app.Add(test.method, "/", handler)
+
+			_, err = app.Test(httptest.NewRequest(test.method, "/", nil))
+			require.NoError(t, err)
+
+			assert.Equal(t, test.want, log.events)
+		})
+	}
+}
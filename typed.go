@@ -0,0 +1,173 @@
+package htmx
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/zeiss/fiber-htmx/components/validate"
+)
+
+// NoBind is a binding for a TypedController that binds nothing.
+type NoBind struct{}
+
+// BindingController is the interface for a controller that binds the request
+// after the request is authorized and before the filters run.
+type BindingController interface {
+	// Bind binds the request.
+	Bind() error
+}
+
+// ValidationController is the interface for a controller that validates the request
+// before the method handler is called.
+type ValidationController interface {
+	// Valid returns true if the request is valid.
+	Valid() bool
+	// Invalid is called instead of the method handler when the request is invalid.
+	Invalid() error
+}
+
+var typedValidator = sync.OnceValue(func() *validator.Validate {
+	v := validator.New()
+	v.RegisterTagNameFunc(validate.TagNameFunc)
+
+	return v
+})
+
+// Validator returns the validator that is used by the typed controllers.
+// Custom validations can be registered on the returned validator.
+func Validator() *validator.Validate {
+	return typedValidator()
+}
+
+var (
+	_ Controller           = (*TypedController[NoBind, NoBind, NoBind])(nil)
+	_ BindingController    = (*TypedController[NoBind, NoBind, NoBind])(nil)
+	_ ValidationController = (*TypedController[NoBind, NoBind, NoBind])(nil)
+)
+
+// TypedController is a controller that binds the params, the query and the body of
+// the request into typed structs and validates them once the request is authorized.
+// The body is bound if the request has a body, it is always validated for POST, PUT and PATCH.
+type TypedController[P, Q, B any] struct {
+	DefaultController
+
+	params P
+	query  Q
+	body   B
+	errors validate.Errors
+}
+
+// Init is called when the controller is initialized.
+func (c *TypedController[P, Q, B]) Init(ctx *fiber.Ctx) error {
+	c.Reset()
+
+	return c.DefaultController.Init(ctx)
+}
+
+// Bind binds and validates the params, the query and the body of the request.
+func (c *TypedController[P, Q, B]) Bind() error {
+	ctx := c.Ctx()
+
+	err := ctx.ParamsParser(&c.params)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	err = ctx.QueryParser(&c.query)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	hasBody := len(ctx.Body()) > 0
+	if hasBody {
+		err = ctx.BodyParser(&c.body)
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	}
+
+	validateBody := hasBody
+	switch ctx.Method() {
+	case fiber.MethodPost, fiber.MethodPut, fiber.MethodPatch:
+		validateBody = true
+	}
+
+	for _, v := range []any{&c.params, &c.query, &c.body} {
+		if v == any(&c.body) && !validateBody {
+			continue
+		}
+
+		err = c.validate(v)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *TypedController[P, Q, B]) validate(v any) error {
+	if reflect.Indirect(reflect.ValueOf(v)).Kind() != reflect.Struct {
+		return nil
+	}
+
+	err := Validator().Struct(v)
+
+	var errs validator.ValidationErrors
+	if errors.As(err, &errs) {
+		c.errors = append(c.errors, errs...)
+		return nil
+	}
+
+	return err
+}
+
+// Params returns the bound params.
+func (c *TypedController[P, Q, B]) Params() *P {
+	return &c.params
+}
+
+// Query returns the bound query.
+func (c *TypedController[P, Q, B]) Query() *Q {
+	return &c.query
+}
+
+// Body returns the bound body.
+func (c *TypedController[P, Q, B]) Body() *B {
+	return &c.body
+}
+
+// Errors returns the validation errors.
+func (c *TypedController[P, Q, B]) Errors() validate.Errors {
+	return c.errors
+}
+
+// Valid returns true if the request is valid.
+func (c *TypedController[P, Q, B]) Valid() bool {
+	return len(c.errors) == 0
+}
+
+// Invalid is called instead of the method handler when the request is invalid.
+// Override it to re-render the form component with the errors.
+func (c *TypedController[P, Q, B]) Invalid() error {
+	return fiber.NewError(fiber.StatusUnprocessableEntity, validator.ValidationErrors(c.errors).Error())
+}
+
+// Reset resets the controller.
+func (c *TypedController[P, Q, B]) Reset() {
+	c.DefaultController.Reset()
+
+	var (
+		params P
+		query  Q
+		body   B
+	)
+
+	c.params = params
+	c.query = query
+	c.body = body
+	c.errors = nil
+}
@@ -0,0 +1,144 @@
+package htmx_test
+
+import (
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authz "github.com/zeiss/fiber-authz"
+	htmx "github.com/zeiss/fiber-htmx"
+	"github.com/zeiss/fiber-htmx/components/validate"
+)
+
+type typedParams struct {
+	ID int `params:"id" json:"id" validate:"required,gt=0"`
+}
+
+type typedBody struct {
+	Name string `form:"name" json:"name" validate:"required,min=3"`
+}
+
+type typedController struct {
+	htmx.TypedController[typedParams, htmx.NoBind, typedBody]
+}
+
+func typedForm(body *typedBody, errs validate.Errors) htmx.Node {
+	return htmx.FormElement(
+		htmx.Input(htmx.Name("name"), htmx.Value(body.Name)),
+		htmx.If(errs.HasError("name"), htmx.Span(htmx.Text("invalid name"))),
+	)
+}
+
+func (c *typedController) Post() error {
+	return c.Ctx().SendString("created " + c.Body().Name)
+}
+
+func (c *typedController) Invalid() error {
+	return c.Render(typedForm(c.Body(), c.Errors()))
+}
+
+func TestTypedController(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		path string
+		body string
+		want string
+	}{
+		{
+			name: "valid",
+			path: "/items/1",
+			body: "name=widget",
+			want: "created widget",
+		},
+		{
+			name: "invalid body",
+			path: "/items/1",
+			body: "name=ab",
+			want: `<form><input name="name" value="ab"><span>invalid name</span></form>`,
+		},
+		{
+			name: "empty body",
+			path: "/items/1",
+			want: `<form><input name="name" value=""><span>invalid name</span></form>`,
+		},
+		{
+			name: "invalid params",
+			path: "/items/0",
+			body: "name=widget",
+			want: `<form><input name="name" value="widget"></form>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Post("/items/:id", htmx.NewControllerHandler(func() htmx.Controller {
+				return &typedController{}
+			}))
+
+			req := httptest.NewRequest(fiber.MethodPost, test.path, strings.NewReader(test.body))
+			req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationForm)
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+			assert.Equal(t, test.want, string(body))
+		})
+	}
+}
+
+type typedQuery struct {
+	Page int `query:"page"`
+}
+
+type deniedTypedController struct {
+	htmx.TypedController[htmx.NoBind, typedQuery, typedBody]
+	bound bool
+}
+
+func (c *deniedTypedController) Bind() error {
+	c.bound = true
+	return c.TypedController.Bind()
+}
+
+func (c *deniedTypedController) GetPrincipial(_ *fiber.Ctx) (authz.AuthzPrincipal, error) {
+	return "alice", nil
+}
+
+func (c *deniedTypedController) GetObject(_ *fiber.Ctx) (authz.AuthzObject, error) {
+	return "item", nil
+}
+
+func (c *deniedTypedController) GetAction(_ *fiber.Ctx) (authz.AuthzAction, error) {
+	return "create", nil
+}
+
+func TestTypedControllerBindsAfterAuthz(t *testing.T) {
+	t.Parallel()
+
+	ctrl := &deniedTypedController{}
+
+	app := fiber.New()
+	app.Post("/items", htmx.NewControllerHandler(func() htmx.Controller {
+		return ctrl
+	}, htmx.Config{AuthzChecker: roleChecker{}}))
+
+	req := httptest.NewRequest(fiber.MethodPost, "/items?page=abc", strings.NewReader("{"))
+	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+
+	assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+	assert.False(t, ctrl.bound)
+}
@@ -0,0 +1,415 @@
+package ws
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	htmx "github.com/zeiss/fiber-htmx"
+	"github.com/zeiss/pkg/server"
+)
+
+// ErrBufferFull is returned when a message is sent to a client whose buffer is full.
+var ErrBufferFull = errors.New("ws: client buffer is full")
+
+// Client is the interface for sending messages over a websocket connection.
+type Client interface {
+	ID() string
+	Messages() chan htmx.Node
+	Send(nodes ...htmx.Node) error
+	Close()
+}
+
+// Manager is the interface for broadcasting messages to clients.
+type Manager interface {
+	Add(Client)
+	Remove(Client)
+	Send() chan<- htmx.Node
+}
+
+// Headers are the htmx headers that are sent with a ws-send message.
+type Headers struct {
+	// Request is always true for messages sent by htmx.
+	Request bool
+	// Trigger is the id of the element that triggered the message.
+	Trigger string
+	// TriggerName is the name of the element that triggered the message.
+	TriggerName string
+	// Target is the id of the target element.
+	Target string
+	// CurrentURL is the current URL of the browser.
+	CurrentURL string
+}
+
+// Payload is a decoded ws-send message.
+type Payload struct {
+	// Headers are the htmx headers of the message.
+	Headers Headers
+	// Values are the form values of the message.
+	Values map[string]any
+}
+
+// UnmarshalJSON decodes a ws-send message, the HEADERS object is decoded into the headers.
+func (p *Payload) UnmarshalJSON(data []byte) error {
+	values := map[string]any{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	headers, ok := values["HEADERS"].(map[string]any)
+	if ok {
+		str := func(key string) string {
+			s, _ := headers[key].(string)
+			return s
+		}
+
+		p.Headers = Headers{
+			Request:     str(htmx.HxRequestHeaderRequest.String()) == "true",
+			Trigger:     str(htmx.HxRequestHeaderTrigger.String()),
+			TriggerName: str(htmx.HxRequestHeaderTriggerName.String()),
+			Target:      str(htmx.HxRequestHeaderTarget.String()),
+			CurrentURL:  str(htmx.HxRequestHeaderCurrentURL.String()),
+		}
+	}
+	delete(values, "HEADERS")
+
+	p.Values = values
+
+	return nil
+}
+
+// Value returns the first value of the form field with the given key.
+func (p *Payload) Value(key string) string {
+	switch v := p.Values[key].(type) {
+	case string:
+		return v
+	case []any:
+		if len(v) > 0 {
+			return fmt.Sprint(v[0])
+		}
+	case nil:
+	default:
+		return fmt.Sprint(v)
+	}
+
+	return ""
+}
+
+// Decode decodes a ws-send message.
+func Decode(data []byte) (*Payload, error) {
+	p := &Payload{}
+	if err := json.Unmarshal(data, p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Swap returns a fragment that is swapped out-of-band into the element with the id with the given strategy.
+// The id is used with or without a leading #, e.g. Swap("list", ...) and Swap("#list", ...) are the same.
+func Swap(id string, strategy htmx.HXSwapStyle, node htmx.Node) htmx.Node {
+	return htmx.OOB("#"+strings.TrimPrefix(id, "#"), strategy, node)
+}
+
+// ClientImpl is the default implementation of the Client interface.
+type ClientImpl struct {
+	messages chan htmx.Node
+	done     chan struct{}
+	once     sync.Once
+	id       string
+}
+
+var _ Client = (*ClientImpl)(nil)
+
+// NewClient creates a new client.
+func NewClient(id string, size int) *ClientImpl {
+	if id == "" {
+		id = uuid.NewString()
+	}
+
+	return &ClientImpl{
+		messages: make(chan htmx.Node, size),
+		done:     make(chan struct{}),
+		id:       id,
+	}
+}
+
+// ID returns the client ID.
+func (c *ClientImpl) ID() string {
+	return c.id
+}
+
+// Messages returns the client messages.
+func (c *ClientImpl) Messages() chan htmx.Node {
+	return c.messages
+}
+
+// Send sends the nodes to the client as a single message.
+// A client that does not read its messages fast enough is closed when its buffer is full
+// and ErrBufferFull is returned, htmx then reconnects and reloads its state.
+func (c *ClientImpl) Send(nodes ...htmx.Node) error {
+	select {
+	case <-c.done:
+		return websocket.ErrCloseSent
+	default:
+	}
+
+	select {
+	case c.messages <- htmx.Fragment(nodes...):
+		return nil
+	default:
+		c.Close()
+		return ErrBufferFull
+	}
+}
+
+// Close closes the client.
+func (c *ClientImpl) Close() {
+	c.once.Do(func() { close(c.done) })
+}
+
+var _ Manager = (*BroadcastManagerImpl)(nil)
+
+// BroadcastManagerImpl is the default implementation of the Manager interface.
+type BroadcastManagerImpl struct {
+	id        string
+	broadcast chan htmx.Node
+	poolSize  int
+	clients   sync.Map
+}
+
+var _ server.Listener = (*BroadcastManagerImpl)(nil)
+
+// NewBroadcastManager creates a new broadcast manager.
+func NewBroadcastManager(poolSize int) *BroadcastManagerImpl {
+	return &BroadcastManagerImpl{
+		id:        uuid.NewString(),
+		broadcast: make(chan htmx.Node),
+		poolSize:  poolSize,
+	}
+}
+
+// Add adds a client to the broadcast manager.
+func (b *BroadcastManagerImpl) Add(client Client) {
+	b.clients.Store(client.ID(), client)
+}
+
+// Remove removes a client from the broadcast manager.
+func (b *BroadcastManagerImpl) Remove(client Client) {
+	b.clients.Delete(client.ID())
+}
+
+// Client returns the client with the given ID.
+func (b *BroadcastManagerImpl) Client(id string) (Client, bool) {
+	v, ok := b.clients.Load(id)
+	if !ok {
+		return nil, false
+	}
+
+	client, ok := v.(Client)
+
+	return client, ok
+}
+
+// Send sends a message to all clients with their Send method.
+// Clients whose buffer is full are closed.
+func (b *BroadcastManagerImpl) Send() chan<- htmx.Node {
+	return b.broadcast
+}
+
+// Start starts the broadcast manager.
+func (b *BroadcastManagerImpl) Start(ctx context.Context, ready server.ReadyFunc, run server.RunFunc) func() error {
+	return func() error {
+		if b.poolSize < 1 {
+			return server.NewServerError(fmt.Errorf("pool size must be greater than 0"))
+		}
+
+		b.startWorkers(ctx)
+
+		ready()
+
+		<-ctx.Done()
+
+		return nil
+	}
+}
+
+func (b *BroadcastManagerImpl) startWorkers(ctx context.Context) {
+	for i := 0; i < b.poolSize; i++ {
+		go func() {
+			for {
+				select {
+				case msg := <-b.broadcast:
+					b.clients.Range(func(key, value any) bool {
+						client, ok := value.(Client)
+						if !ok {
+							return true
+						}
+
+						// a client whose buffer is full is closed by Send
+						_ = client.Send(msg)
+
+						return true
+					})
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+}
+
+// MessageHandler handles a ws-send message of a client.
+type MessageHandler func(client Client, payload *Payload) error
+
+// Config is the configuration for the websocket handler.
+type Config struct {
+	// BufferSize is the number of messages that are buffered for a client.
+	BufferSize int
+	// ReadLimit is the maximum size in bytes of a message read from a client.
+	ReadLimit int64
+	// Origins are the allowed origins of the websocket connection e.g. https://example.com.
+	// Only the origin of the host of the request is allowed if empty, * allows all origins.
+	Origins []string
+	// ErrorHandler is called when a message could not be handled.
+	ErrorHandler func(client Client, err error)
+}
+
+// ConfigDefault is the default configuration for the websocket handler.
+var ConfigDefault = Config{
+	BufferSize:   16,
+	ReadLimit:    64 * 1024,
+	ErrorHandler: func(client Client, err error) {},
+}
+
+// NewWSHandler creates a new websocket handler.
+// Every connection is added to the manager and the messages of the client are passed to the handler.
+func NewWSHandler(manager Manager, handler MessageHandler, config ...Config) fiber.Handler {
+	cfg := configDefault(config...)
+
+	ws := websocket.New(func(conn *websocket.Conn) {
+		client := NewClient(uuid.NewString(), cfg.BufferSize)
+		manager.Add(client)
+
+		defer manager.Remove(client)
+		defer client.Close()
+
+		conn.SetReadLimit(cfg.ReadLimit)
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			write(conn, client, cfg)
+		}()
+
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				break
+			}
+
+			payload, err := Decode(data)
+			if err != nil {
+				cfg.ErrorHandler(client, err)
+				continue
+			}
+
+			if err := handler(client, payload); err != nil {
+				cfg.ErrorHandler(client, err)
+			}
+		}
+
+		client.Close()
+		wg.Wait()
+	})
+
+	return func(c *fiber.Ctx) error {
+		if !cfg.allowOrigin(c) {
+			return fiber.ErrForbidden
+		}
+
+		return ws(c)
+	}
+}
+
+// allowOrigin returns true if the origin of the request is allowed.
+// Requests without an origin are not sent by browsers and are allowed.
+func (cfg Config) allowOrigin(c *fiber.Ctx) bool {
+	origin := c.Get(fiber.HeaderOrigin)
+	if origin == "" || slices.Contains(cfg.Origins, "*") {
+		return true
+	}
+
+	if len(cfg.Origins) > 0 {
+		return slices.Contains(cfg.Origins, origin)
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(u.Host, string(c.Request().Host()))
+}
+
+func write(conn *websocket.Conn, client *ClientImpl, cfg Config) {
+	var buf bytes.Buffer
+
+	for {
+		select {
+		case <-client.done:
+			_ = conn.Close()
+
+			return
+		case msg := <-client.Messages():
+			buf.Reset()
+
+			if err := msg.Render(&buf); err != nil {
+				cfg.ErrorHandler(client, err)
+				continue
+			}
+
+			if err := conn.WriteMessage(websocket.TextMessage, buf.Bytes()); err != nil {
+				cfg.ErrorHandler(client, err)
+				_ = conn.Close()
+
+				return
+			}
+		}
+	}
+}
+
+// Helper function to set default values
+func configDefault(config ...Config) Config {
+	if len(config) < 1 {
+		return ConfigDefault
+	}
+
+	// Override default config
+	cfg := config[0]
+
+	if cfg.BufferSize < 1 {
+		cfg.BufferSize = ConfigDefault.BufferSize
+	}
+
+	if cfg.ReadLimit < 1 {
+		cfg.ReadLimit = ConfigDefault.ReadLimit
+	}
+
+	if cfg.ErrorHandler == nil {
+		cfg.ErrorHandler = ConfigDefault.ErrorHandler
+	}
+
+	return cfg
+}
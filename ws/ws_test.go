@@ -0,0 +1,362 @@
+package ws_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	htmx "github.com/zeiss/fiber-htmx"
+	"github.com/zeiss/fiber-htmx/ws"
+)
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		data    string
+		headers ws.Headers
+		values  map[string]any
+		err     bool
+	}{
+		{
+			name: "message",
+			data: `{"name":"widget","HEADERS":{"HX-Request":"true","HX-Trigger":"form","HX-Trigger-Name":"create","HX-Target":"list","HX-Current-URL":"http://localhost/projects"}}`,
+			headers: ws.Headers{
+				Request:     true,
+				Trigger:     "form",
+				TriggerName: "create",
+				Target:      "list",
+				CurrentURL:  "http://localhost/projects",
+			},
+			values: map[string]any{"name": "widget"},
+		},
+		{
+			name:    "without headers",
+			data:    `{"name":"widget"}`,
+			headers: ws.Headers{},
+			values:  map[string]any{"name": "widget"},
+		},
+		{
+			name:    "invalid headers",
+			data:    `{"HEADERS":"invalid"}`,
+			headers: ws.Headers{},
+			values:  map[string]any{},
+		},
+		{
+			name: "invalid json",
+			data: `{"name":`,
+			err:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := ws.Decode([]byte(test.data))
+			if test.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.headers, p.Headers)
+			assert.Equal(t, test.values, p.Values)
+		})
+	}
+}
+
+func TestPayloadValue(t *testing.T) {
+	t.Parallel()
+
+	p, err := ws.Decode([]byte(`{"name":"widget","tags":["a","b"],"empty":[],"count":3,"done":true,"none":null}`))
+	require.NoError(t, err)
+
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{key: "name", want: "widget"},
+		{key: "tags", want: "a"},
+		{key: "empty", want: ""},
+		{key: "count", want: "3"},
+		{key: "done", want: "true"},
+		{key: "none", want: ""},
+		{key: "missing", want: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.key, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.want, p.Value(test.key))
+		})
+	}
+}
+
+func TestClientSend(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		sends int
+		close bool
+		err   error
+	}{
+		{
+			name:  "buffered",
+			sends: 2,
+		},
+		{
+			name:  "buffer full",
+			sends: 3,
+			err:   ws.ErrBufferFull,
+		},
+		{
+			name:  "closed",
+			sends: 1,
+			close: true,
+			err:   websocket.ErrCloseSent,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := ws.NewClient("", 2)
+			if test.close {
+				client.Close()
+			}
+
+			var err error
+			for range test.sends {
+				err = client.Send(htmx.Text("hello"))
+			}
+
+			assert.ErrorIs(t, err, test.err)
+			assert.NotEmpty(t, client.ID())
+		})
+	}
+}
+
+func TestBroadcastManager(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	m := ws.NewBroadcastManager(2)
+
+	ready := make(chan struct{})
+	go func() {
+		_ = m.Start(ctx, func() { close(ready) }, func(func() error) {})()
+	}()
+	<-ready
+
+	alice := ws.NewClient("alice", 1)
+	bob := ws.NewClient("bob", 1)
+	m.Add(alice)
+	m.Add(bob)
+
+	c, ok := m.Client("alice")
+	require.True(t, ok)
+	assert.Equal(t, alice, c)
+
+	m.Send() <- htmx.Text("hello")
+
+	for _, client := range []*ws.ClientImpl{alice, bob} {
+		select {
+		case msg := <-client.Messages():
+			var b strings.Builder
+			require.NoError(t, msg.Render(&b))
+			assert.Equal(t, "hello", b.String())
+		case <-time.After(time.Second):
+			t.Fatalf("client %s did not receive the message", client.ID())
+		}
+	}
+
+	m.Remove(bob)
+
+	_, ok = m.Client("bob")
+	assert.False(t, ok)
+
+	m.Send() <- htmx.Text("again")
+
+	select {
+	case <-alice.Messages():
+	case <-time.After(time.Second):
+		t.Fatal("client alice did not receive the message")
+	}
+
+	assert.Empty(t, bob.Messages())
+}
+
+// sendClient records the messages that are sent with Send.
+type sendClient struct {
+	sent chan htmx.Node
+}
+
+func (c *sendClient) ID() string                    { return "send" }
+func (c *sendClient) Messages() chan htmx.Node      { return nil }
+func (c *sendClient) Send(nodes ...htmx.Node) error { c.sent <- htmx.Fragment(nodes...); return nil }
+func (c *sendClient) Close()                        {}
+
+func TestBroadcastManagerSlowClient(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	m := ws.NewBroadcastManager(1)
+
+	ready := make(chan struct{})
+	go func() {
+		_ = m.Start(ctx, func() { close(ready) }, func(func() error) {})()
+	}()
+	<-ready
+
+	client := &sendClient{sent: make(chan htmx.Node, 1)}
+	m.Add(client)
+
+	slow := ws.NewClient("slow", 1)
+	m.Add(slow)
+
+	m.Send() <- htmx.Text("hello")
+	m.Send() <- htmx.Text("again")
+
+	for range 2 {
+		select {
+		case <-client.sent:
+		case <-time.After(time.Second):
+			t.Fatal("the message was not sent with Send")
+		}
+	}
+
+	// the buffer of the slow client was full for the second message
+	assert.ErrorIs(t, slow.Send(htmx.Text("closed")), websocket.ErrCloseSent)
+}
+
+func TestBroadcastManagerPoolSize(t *testing.T) {
+	t.Parallel()
+
+	m := ws.NewBroadcastManager(0)
+
+	err := m.Start(context.Background(), func() {}, func(func() error) {})()
+	require.Error(t, err)
+}
+
+func TestSwap(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		id       string
+		strategy htmx.HXSwapStyle
+		want     string
+	}{
+		{
+			name:     "id",
+			id:       "list",
+			strategy: htmx.HxSwapOuterHTML,
+			want:     `<ul hx-swap-oob="outerHTML:#list" id="list"></ul>`,
+		},
+		{
+			name:     "selector",
+			id:       "#list",
+			strategy: htmx.HxSwapOuterHTML,
+			want:     `<ul hx-swap-oob="outerHTML:#list" id="list"></ul>`,
+		},
+		{
+			name:     "inner",
+			id:       "list",
+			strategy: htmx.HxSwapBeforeEnd,
+			want:     `<div hx-swap-oob="beforeend:#list"><ul id="list"></ul></div>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var b strings.Builder
+			require.NoError(t, ws.Swap(test.id, test.strategy, htmx.Ul(htmx.ID("list"))).Render(&b))
+			assert.Equal(t, test.want, b.String())
+		})
+	}
+}
+
+func TestNewWSHandlerOrigin(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		origins []string
+		origin  string
+		allowed bool
+	}{
+		{
+			name:    "same origin",
+			origin:  "http://example.com",
+			allowed: true,
+		},
+		{
+			name:   "cross origin",
+			origin: "http://evil.com",
+		},
+		{
+			name:    "no origin",
+			allowed: true,
+		},
+		{
+			name:    "allowed origin",
+			origins: []string{"http://app.example.com"},
+			origin:  "http://app.example.com",
+			allowed: true,
+		},
+		{
+			name:    "not allowed origin",
+			origins: []string{"http://app.example.com"},
+			origin:  "http://example.com",
+		},
+		{
+			name:    "all origins",
+			origins: []string{"*"},
+			origin:  "http://evil.com",
+			allowed: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			app := fiber.New()
+			app.Get("/ws", ws.NewWSHandler(ws.NewBroadcastManager(1), func(ws.Client, *ws.Payload) error {
+				return nil
+			}, ws.Config{Origins: test.origins}))
+
+			req := httptest.NewRequest(fiber.MethodGet, "/ws", nil)
+			req.Host = "example.com"
+			if test.origin != "" {
+				req.Header.Set(fiber.HeaderOrigin, test.origin)
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			if test.allowed {
+				assert.NotEqual(t, fiber.StatusForbidden, resp.StatusCode)
+			} else {
+				assert.Equal(t, fiber.StatusForbidden, resp.StatusCode)
+			}
+		})
+	}
+}